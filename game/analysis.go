@@ -0,0 +1,161 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// Outcome is the result of a move under perfect play by both players, for
+// the player who made it.
+type Outcome string
+
+const (
+	OutcomeWin  Outcome = "win"
+	OutcomeDraw Outcome = "draw"
+	OutcomeLoss Outcome = "loss"
+	// OutcomeUnknown is given for moves that could not be searched to the
+	// end of the game within the time budget.
+	OutcomeUnknown Outcome = "unknown"
+)
+
+// MoveAnalysis describes the outcome of playing on an empty square. Symbol is
+// the symbol placed when the players share symbols, zero meaning the player's
+// own, as for Move.
+type MoveAnalysis struct {
+	X       int         `json:"x"`
+	Y       int         `json:"y"`
+	Symbol  SquareState `json:"symbol,omitempty"`
+	Outcome Outcome     `json:"outcome"`
+	// Plies is the number of moves, this one included, until the game ends
+	// with the outcome.
+	Plies int `json:"plies,omitempty"`
+}
+
+type AnalysisResponse struct {
+	Result     Result         `json:"result,omitempty"`
+	NextPlayer rune           `json:"nextPlayer"`
+	Moves      []MoveAnalysis `json:"moves"`
+}
+
+// AnalysisHandler accepts a TicTacToeState and responds with an
+// AnalysisResponse giving the outcome of every move the player whose turn it
+// is could make.
+func AnalysisHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "could read request", err)
+		return
+	}
+
+	req := &TicTacToeState{}
+	err = json.Unmarshal(b, req)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "could not interpret request", err)
+		return
+	}
+
+	err = req.initialize()
+	if err != nil {
+		writeHTTPError(w, errorStatus(err), "invalid game settings", err)
+		return
+	}
+
+	budget := time.Duration(req.TimeBudget) * time.Millisecond
+	if budget <= 0 {
+		budget = defaultTimeBudget
+	}
+	result, _ := req.getGameResult()
+	resp := AnalysisResponse{
+		Result:     result,
+		NextPlayer: req.playersTurn(),
+		Moves:      []MoveAnalysis{},
+	}
+	if result == ResultNone {
+		resp.Moves = analyzeMoves(r.Context(), *req, budget)
+	}
+
+	b, err = json.Marshal(resp)
+	if err != nil {
+		writeHTTPError(w, http.StatusInternalServerError, "failed to marshal response", err)
+		return
+	}
+
+	_, err = w.Write(b)
+	if err != nil {
+		log.Print(err)
+	}
+}
+
+// analyzeMoves works out the outcome of every move the player whose turn it
+// is could make, with each symbol they may place, sharing the time budget
+// between them.
+func analyzeMoves(ctx context.Context, gameState TicTacToeState, budget time.Duration) []MoveAnalysis {
+	deadline := time.Now().Add(budget)
+	moves := gameState.legalMoves()
+	symbols := []SquareState{SquareStateEmpty}
+	if gameState.sharesSymbols() {
+		symbols = gameState.symbols()
+	}
+	total := len(moves) * len(symbols)
+	analyses := make([]MoveAnalysis, 0, total)
+	for _, move := range moves {
+		for _, symbol := range symbols {
+			share := time.Until(deadline) / time.Duration(total-len(analyses))
+			if share <= 0 {
+				share = time.Nanosecond
+			}
+			analyses = append(analyses, analyzeMove(ctx, gameState, move[0], move[1], symbol, share))
+		}
+	}
+
+	return analyses
+}
+
+// analyzeMove works out the outcome of the player whose turn it is placing
+// symbol on x, y, or their own symbol when it is empty, by searching the
+// position that follows for the best reply.
+func analyzeMove(ctx context.Context, gameState TicTacToeState, x, y int, symbol SquareState, budget time.Duration) MoveAnalysis {
+	analysis := MoveAnalysis{X: x, Y: y, Symbol: symbol}
+	gs := gameState.clone()
+	err := gs.placeSymbol(x, y, symbol)
+	if err != nil {
+		analysis.Outcome = OutcomeUnknown
+		return analysis
+	}
+
+	result, row := gs.moveResult(x, y)
+	switch winner := gs.winner(result, row); {
+	case result == ResultNone:
+	case winner == gameState.playersTurn():
+		analysis.Outcome, analysis.Plies = OutcomeWin, 1
+		return analysis
+	case winner == 0:
+		analysis.Outcome, analysis.Plies = OutcomeDraw, 1
+		return analysis
+	default:
+		analysis.Outcome, analysis.Plies = OutcomeLoss, 1
+		return analysis
+	}
+
+	// The score is that of the opponent's best reply, which wins or loses
+	// on the move scoreWin less the magnitude of the score plies later.
+	score, _, solved, err := deepen(ctx, gs, budget)
+	switch {
+	case err != nil, !solved:
+		analysis.Outcome = OutcomeUnknown
+	case score > 0:
+		analysis.Outcome, analysis.Plies = OutcomeLoss, scoreWin-score+2
+	case score < 0:
+		analysis.Outcome, analysis.Plies = OutcomeWin, scoreWin+score+2
+	default:
+		analysis.Outcome, analysis.Plies = OutcomeDraw, len(gs.emptySquares())+1
+	}
+
+	return analysis
+}
@@ -0,0 +1,150 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// expectedAnalysis converts the reference score of a move into its analysis.
+func expectedAnalysis(gameState TicTacToeState, x, y, score int) MoveAnalysis {
+	switch {
+	case score > 0:
+		return MoveAnalysis{X: x, Y: y, Outcome: OutcomeWin, Plies: scoreWin - score + 1}
+	case score < 0:
+		return MoveAnalysis{X: x, Y: y, Outcome: OutcomeLoss, Plies: scoreWin + score + 1}
+	}
+
+	return MoveAnalysis{X: x, Y: y, Outcome: OutcomeDraw, Plies: len(gameState.emptySquares())}
+}
+
+func TestAnalyzeMoves_AllPositions(t *testing.T) {
+	positions := make(map[string]TicTacToeState)
+	reachablePositions(TicTacToeState{Board: makeBoard(3), Turn: 1}, positions)
+
+	memo := make(map[string]int)
+	for key, gameState := range positions {
+		children := childPositions(gameState)
+		analyses := analyzeMoves(context.Background(), gameState, time.Second)
+		assert.Len(t, analyses, len(children), key)
+		for _, analysis := range analyses {
+			child := children[[2]int{analysis.X, analysis.Y}]
+			want := expectedAnalysis(gameState, analysis.X, analysis.Y, childScore(child, memo))
+			assert.Equal(t, want, analysis, key)
+		}
+	}
+}
+
+func TestAnalyzeMoves_FourByFour(t *testing.T) {
+	gameState := TicTacToeState{
+		Turn:      10,
+		WinLength: 4,
+		Board: [][]SquareState{
+			{SquareStateCross, SquareStateNaught, SquareStateEmpty, SquareStateCross},
+			{SquareStateEmpty, SquareStateCross, SquareStateNaught, SquareStateEmpty},
+			{SquareStateNaught, SquareStateEmpty, SquareStateCross, SquareStateEmpty},
+			{SquareStateNaught, SquareStateEmpty, SquareStateCross, SquareStateEmpty},
+		},
+	}
+
+	memo := make(map[string]int)
+	children := childPositions(gameState)
+	analyses := analyzeMoves(context.Background(), gameState, time.Second)
+	assert.Len(t, analyses, len(children))
+	for _, analysis := range analyses {
+		child := children[[2]int{analysis.X, analysis.Y}]
+		want := expectedAnalysis(gameState, analysis.X, analysis.Y, childScore(child, memo))
+		assert.Equal(t, want, analysis)
+	}
+	assert.Contains(t, analyses, MoveAnalysis{X: 3, Y: 3, Outcome: OutcomeDraw, Plies: 7})
+}
+
+func TestAnalyzeMoves_OutOfTime(t *testing.T) {
+	gameState := TicTacToeState{Board: makeBoard(9), WinLength: 5, Turn: 1}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	analyses := analyzeMoves(ctx, gameState, time.Second)
+	assert.Len(t, analyses, 81)
+	for _, analysis := range analyses {
+		assert.Equal(t, OutcomeUnknown, analysis.Outcome)
+	}
+}
+
+func TestAnalyzeMoves_Wild(t *testing.T) {
+	analyses := analyzeMoves(context.Background(), wildWinBoard(), time.Second)
+	assert.Len(t, analyses, 10)
+	assert.Contains(t, analyses, MoveAnalysis{X: 2, Y: 0, Symbol: SquareStateNaught, Outcome: OutcomeWin, Plies: 1})
+	for _, analysis := range analyses {
+		assert.NotEqual(t, OutcomeUnknown, analysis.Outcome)
+	}
+}
+
+func TestAnalyzeMoves_OrderChaos(t *testing.T) {
+	x, o := SquareStateCross, SquareStateNaught
+	gameState := makeOrderChaosState([]SquareState{o, o, o, o}, [2]int{0, 0}, [2]int{1, 0}, [2]int{2, 0}, [2]int{3, 0})
+
+	analyses := analyzeMoves(context.Background(), gameState, 200*time.Millisecond)
+	assert.Len(t, analyses, 2*len(gameState.emptySquares()))
+	assert.Contains(t, analyses, MoveAnalysis{X: 4, Y: 0, Symbol: o, Outcome: OutcomeWin, Plies: 1})
+	assert.NotContains(t, analyses, MoveAnalysis{X: 4, Y: 0, Symbol: x, Outcome: OutcomeWin, Plies: 1})
+}
+
+func TestAnalysisHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+		expResponse   AnalysisResponse
+	}{
+		{
+			name:          "Naughts to move",
+			body:          `{"board": [[48,88,0],[88,88,48],[48,0,88]]}`,
+			expStatusCode: http.StatusOK,
+			expResponse: AnalysisResponse{
+				NextPlayer: '0',
+				Moves: []MoveAnalysis{
+					{X: 2, Y: 0, Outcome: OutcomeLoss, Plies: 2},
+					{X: 1, Y: 2, Outcome: OutcomeDraw, Plies: 2},
+				},
+			},
+		},
+		{
+			name:          "Game over",
+			body:          `{"board": [[88,88,88],[48,48,0],[0,0,0]]}`,
+			expStatusCode: http.StatusOK,
+			expResponse: AnalysisResponse{
+				Result:     ResultNInARow,
+				NextPlayer: '0',
+				Moves:      []MoveAnalysis{},
+			},
+		},
+		{
+			name:          "Invalid win length",
+			body:          `{"winLength": 4}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/analysis", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			AnalysisHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+			if tt.expStatusCode != http.StatusOK {
+				return
+			}
+
+			resp := AnalysisResponse{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expResponse, resp)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package game
+
+import (
+	"context"
+	"math/rand"
+)
+
+// Difficulty selects how well the computer plays.
+type Difficulty string
+
+const (
+	// DifficultyRandom plays any empty square.
+	DifficultyRandom Difficulty = "random"
+	// DifficultyBeginner completes its own lines and blocks its opponent's
+	// lines, or in misère avoids completing its own lines, but otherwise
+	// plays at random.
+	DifficultyBeginner Difficulty = "beginner"
+	// DifficultyIntermediate plays the moves of the requested engine but
+	// occasionally makes a mistake.
+	DifficultyIntermediate Difficulty = "intermediate"
+	// DifficultyPerfect always plays the moves of the requested engine.
+	DifficultyPerfect Difficulty = "perfect"
+)
+
+// defaultMistakeChance is the chance of an intermediate player making a
+// mistake when the request does not specify one.
+const defaultMistakeChance = 0.25
+
+// withDifficulty returns the engine that plays at the given difficulty. The
+// perfect and intermediate difficulties play the moves of the given engine,
+// the intermediate one making mistakes with the given chance, or with
+// defaultMistakeChance when it is nil.
+func withDifficulty(difficulty Difficulty, chance *float64, engine Engine, rng *rand.Rand) (Engine, error) {
+	mistakeChance := defaultMistakeChance
+	if chance != nil {
+		mistakeChance = *chance
+	}
+	if mistakeChance < 0 || mistakeChance > 1 {
+		return nil, ErrInvalidMistakeChance
+	}
+
+	switch difficulty {
+	case DifficultyRandom:
+		return &randomEngine{rng: rng}, nil
+	case DifficultyBeginner:
+		return &beginnerEngine{rng: rng}, nil
+	case DifficultyIntermediate:
+		return &mistakeEngine{mistakeChance: mistakeChance, engine: engine, rng: rng}, nil
+	case DifficultyPerfect, "":
+		return engine, nil
+	}
+
+	return nil, ErrUnknownDifficulty
+}
+
+// beginnerEngine looks one move ahead. It wins when it can, blocks its
+// opponent from winning on their next move, and otherwise plays at random.
+// In misère, where nothing wins on the move, it plays at random among the
+// moves that do not lose on the spot.
+type beginnerEngine struct {
+	rng *rand.Rand
+}
+
+func (e *beginnerEngine) ComputeMove(ctx context.Context, gameState TicTacToeState) (Move, Evaluation, error) {
+	moves := gameState.legalMoves()
+	if len(moves) == 0 {
+		return Move{}, 0, ErrNoMoves
+	}
+	if gameState.sharesSymbols() {
+		return e.computeSharedMove(gameState, moves)
+	}
+
+	// Win
+	for _, move := range moves {
+		if gameState.isWinningMove(move[0], move[1], gameState.Turn) {
+			return Move{X: move[0], Y: move[1]}, 1, nil
+		}
+	}
+
+	// Block
+	for _, move := range moves {
+		if gameState.isWinningMove(move[0], move[1], gameState.Turn+1) {
+			return Move{X: move[0], Y: move[1]}, 0, nil
+		}
+	}
+
+	// Avoid losing
+	safe := make([][2]int, 0, len(moves))
+	for _, move := range moves {
+		if gameState.resultAfter(move[0], move[1], gameState.Turn) != ResultMisereLoss {
+			safe = append(safe, move)
+		}
+	}
+	if len(safe) > 0 {
+		moves = safe
+	}
+
+	move := moves[e.rng.Intn(len(moves))]
+	return Move{X: move[0], Y: move[1]}, 0, nil
+}
+
+// computeSharedMove is ComputeMove for games in which the players share
+// symbols, where a line cannot simply be blocked, since a piece of the wrong
+// symbol placed in it may help to complete another. It wins when it can and
+// otherwise plays at random among the moves that neither lose on the spot nor
+// leave its opponent a win on their next move.
+func (e *beginnerEngine) computeSharedMove(gameState TicTacToeState, squares [][2]int) (Move, Evaluation, error) {
+	var moves []Move
+	for _, square := range squares {
+		for _, symbol := range gameState.symbols() {
+			moves = append(moves, Move{X: square[0], Y: square[1], Symbol: symbol})
+		}
+	}
+
+	player := gameState.playersTurn()
+	safe := make([]Move, 0, len(moves))
+	for _, move := range moves {
+		gs := gameState.clone()
+		_ = gs.placeSymbol(move.X, move.Y, move.Symbol)
+		result, row := gs.moveResult(move.X, move.Y)
+		switch winner := gs.winner(result, row); {
+		case result != ResultNone && winner == player:
+			return move, 1, nil
+		case result == ResultNone && !gs.hasWinningMove():
+			safe = append(safe, move)
+		}
+	}
+	if len(safe) > 0 {
+		moves = safe
+	}
+
+	return moves[e.rng.Intn(len(moves))], 0, nil
+}
+
+// mistakeEngine plays the moves of its engine except that with a chance of
+// mistakeChance it plays at random instead.
+type mistakeEngine struct {
+	mistakeChance float64
+	engine        Engine
+	rng           *rand.Rand
+}
+
+func (e *mistakeEngine) ComputeMove(ctx context.Context, gameState TicTacToeState) (Move, Evaluation, error) {
+	if e.rng.Float64() < e.mistakeChance {
+		random := &randomEngine{rng: e.rng}
+		return random.ComputeMove(ctx, gameState)
+	}
+
+	return e.engine.ComputeMove(ctx, gameState)
+}
+
+// isWinningMove reports whether the player whose turn it is on the given turn
+// would complete a line by occupying x, y.
+func (t *TicTacToeState) isWinningMove(x, y, turn int) bool {
+	return t.resultAfter(x, y, turn) == ResultNInARow
+}
+
+// hasWinningMove reports whether the player whose turn it is can win on their
+// move with any of the symbols they may place.
+func (t *TicTacToeState) hasWinningMove() bool {
+	player := t.playersTurn()
+	for _, square := range t.legalMoves() {
+		for _, symbol := range t.symbols() {
+			gs := t.clone()
+			if gs.placeSymbol(square[0], square[1], symbol) != nil {
+				continue
+			}
+			result, row := gs.moveResult(square[0], square[1])
+			if result != ResultNone && gs.winner(result, row) == player {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// resultAfter returns the result of the game after the player whose turn it
+// is on the given turn occupies x, y, or ResultNone if they cannot.
+func (t *TicTacToeState) resultAfter(x, y, turn int) Result {
+	gs := t.clone()
+	gs.Turn = turn
+	err := gs.occupyPosition(x, y)
+	if err != nil {
+		return ResultNone
+	}
+	result, _ := gs.moveResult(x, y)
+
+	return result
+}
@@ -0,0 +1,166 @@
+package game
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithDifficulty(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	chance := func(c float64) *float64 { return &c }
+	engine := &mctsEngine{}
+	tests := []struct {
+		name          string
+		difficulty    Difficulty
+		mistakeChance *float64
+		expEngine     Engine
+		expErr        error
+	}{
+		{
+			name:      "Default",
+			expEngine: engine,
+		},
+		{
+			name:       "Random",
+			difficulty: DifficultyRandom,
+			expEngine:  &randomEngine{rng: rng},
+		},
+		{
+			name:       "Beginner",
+			difficulty: DifficultyBeginner,
+			expEngine:  &beginnerEngine{rng: rng},
+		},
+		{
+			name:       "Intermediate",
+			difficulty: DifficultyIntermediate,
+			expEngine:  &mistakeEngine{mistakeChance: defaultMistakeChance, engine: engine, rng: rng},
+		},
+		{
+			name:          "Intermediate with mistake chance",
+			difficulty:    DifficultyIntermediate,
+			mistakeChance: chance(0.5),
+			expEngine:     &mistakeEngine{mistakeChance: 0.5, engine: engine, rng: rng},
+		},
+		{
+			name:          "Intermediate without mistakes",
+			difficulty:    DifficultyIntermediate,
+			mistakeChance: chance(0),
+			expEngine:     &mistakeEngine{mistakeChance: 0, engine: engine, rng: rng},
+		},
+		{
+			name:       "Perfect",
+			difficulty: DifficultyPerfect,
+			expEngine:  engine,
+		},
+		{
+			name:       "Unknown",
+			difficulty: "impossible",
+			expErr:     ErrUnknownDifficulty,
+		},
+		{
+			name:          "Invalid mistake chance",
+			difficulty:    DifficultyIntermediate,
+			mistakeChance: chance(1.5),
+			expErr:        ErrInvalidMistakeChance,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := withDifficulty(tt.difficulty, tt.mistakeChance, engine, rng)
+			assert.ErrorIs(t, err, tt.expErr)
+			assert.Equal(t, tt.expEngine, e)
+		})
+	}
+}
+
+func TestDifficulty_ComputeMove(t *testing.T) {
+	// Crosses can win at 2, 0 and must also block naughts at 2, 1.
+	canWin := TicTacToeState{
+		Turn: 5,
+		Board: [][]SquareState{
+			{SquareStateCross, SquareStateCross, SquareStateEmpty},
+			{SquareStateNaught, SquareStateNaught, SquareStateEmpty},
+			{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+		},
+	}
+	// Naughts must block crosses at 2, 1.
+	mustBlock := TicTacToeState{
+		Turn: 4,
+		Board: [][]SquareState{
+			{SquareStateNaught, SquareStateEmpty, SquareStateEmpty},
+			{SquareStateCross, SquareStateCross, SquareStateEmpty},
+			{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+		},
+	}
+	// Naughts lose in misère by completing the anti-diagonal at 2, 0.
+	misere := TicTacToeState{
+		Turn:    8,
+		Variant: VariantMisere,
+		Board: [][]SquareState{
+			{SquareStateCross, SquareStateCross, SquareStateEmpty},
+			{SquareStateNaught, SquareStateNaught, SquareStateCross},
+			{SquareStateNaught, SquareStateCross, SquareStateEmpty},
+		},
+	}
+	tests := []struct {
+		name      string
+		engine    Engine
+		gameState TicTacToeState
+		expMove   Move
+	}{
+		{
+			name:      "Beginner Wins",
+			engine:    &beginnerEngine{rng: rand.New(rand.NewSource(1))},
+			gameState: canWin,
+			expMove:   Move{X: 2, Y: 0},
+		},
+		{
+			name:      "Beginner Blocks",
+			engine:    &beginnerEngine{rng: rand.New(rand.NewSource(1))},
+			gameState: mustBlock,
+			expMove:   Move{X: 2, Y: 1},
+		},
+		{
+			name:      "Beginner Avoids Losing in Misère",
+			engine:    &beginnerEngine{rng: rand.New(rand.NewSource(1))},
+			gameState: misere,
+			expMove:   Move{X: 2, Y: 2},
+		},
+		{
+			name:      "Intermediate without mistakes",
+			engine:    &mistakeEngine{engine: &minimaxEngine{}, rng: rand.New(rand.NewSource(1))},
+			gameState: mustBlock,
+			expMove:   Move{X: 2, Y: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			move, _, err := tt.engine.ComputeMove(context.Background(), tt.gameState)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expMove, move)
+		})
+	}
+}
+
+func TestDifficulty_MistakesPlayEmptySquares(t *testing.T) {
+	gameState := TicTacToeState{
+		Turn: 4,
+		Board: [][]SquareState{
+			{SquareStateNaught, SquareStateEmpty, SquareStateCross},
+			{SquareStateCross, SquareStateCross, SquareStateEmpty},
+			{SquareStateNaught, SquareStateEmpty, SquareStateNaught},
+		},
+	}
+	e := &mistakeEngine{mistakeChance: 1, engine: &minimaxEngine{}, rng: rand.New(rand.NewSource(1))}
+	played := make(map[Move]bool)
+	for i := 0; i < 100; i++ {
+		move, _, err := e.ComputeMove(context.Background(), gameState)
+		assert.NoError(t, err)
+		assert.False(t, gameState.isOccupied(move.X, move.Y))
+		played[move] = true
+	}
+	assert.Len(t, played, 3)
+}
@@ -0,0 +1,203 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Names of the engines registered by this package.
+const (
+	EngineMinimax  = "minimax"
+	EngineMCTS     = "mcts"
+	EngineRandom   = "random"
+	EngineBeginner = "beginner"
+)
+
+// ErrNoMoves is returned by an engine asked to move when there are no empty
+// squares left.
+var ErrNoMoves = errors.New("no moves available")
+
+// Move is a square for the player whose turn it is to occupy. Z is the
+// square's layer on a Qubic cube, its board in Notakto and zero on a board.
+// Symbol is the symbol placed when the players share symbols, zero meaning
+// the player's own, and Number the number placed in numerical tic-tac-toe.
+// From is the square a piece slides from in the movement phase of Three Men's
+// Morris, and nil for a move that places a new piece.
+type Move struct {
+	X      int         `json:"x"`
+	Y      int         `json:"y"`
+	Z      int         `json:"z,omitempty"`
+	Symbol SquareState `json:"symbol,omitempty"`
+	Number int         `json:"number,omitempty"`
+	From   *Square     `json:"from,omitempty"`
+}
+
+// Square is the square x, y of a board.
+type Square struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// Evaluation is an engine's assessment of the position after its move, from
+// the point of view of the player who made it. It ranges from -1, a certain
+// loss, through 0, a draw or an even game, to 1, a certain win.
+type Evaluation float64
+
+// Engine chooses moves for the computer player.
+type Engine interface {
+	// ComputeMove returns the move the engine would make for the player
+	// whose turn it is along with its evaluation of that move. Engines that
+	// search for as long as they are allowed return the best move found so
+	// far once ctx is done.
+	ComputeMove(ctx context.Context, gameState TicTacToeState) (Move, Evaluation, error)
+}
+
+// EngineOptions configure an engine for a single game state request.
+type EngineOptions struct {
+	// Iterations limits the number of iterations of an engine that improves
+	// its move the longer it runs, zero meaning no limit.
+	Iterations int
+	// TimeBudget limits how long such an engine runs, zero meaning the
+	// engine's default.
+	TimeBudget time.Duration
+	// Rand is the source of randomness for engines that need one.
+	Rand *rand.Rand
+}
+
+// EngineFactory creates an engine configured with the given options.
+type EngineFactory func(opts EngineOptions) Engine
+
+var (
+	enginesMu sync.RWMutex
+	engines   = make(map[string]EngineFactory)
+)
+
+func init() {
+	RegisterEngine(EngineMinimax, func(opts EngineOptions) Engine {
+		return &minimaxEngine{budget: opts.TimeBudget}
+	})
+	RegisterEngine(EngineMCTS, func(opts EngineOptions) Engine {
+		return &mctsEngine{
+			iterations: opts.Iterations,
+			budget:     opts.TimeBudget,
+			rng:        opts.Rand,
+		}
+	})
+	RegisterEngine(EngineRandom, func(opts EngineOptions) Engine {
+		return &randomEngine{rng: opts.Rand}
+	})
+	RegisterEngine(EngineBeginner, func(opts EngineOptions) Engine {
+		return &beginnerEngine{rng: opts.Rand}
+	})
+}
+
+// RegisterEngine makes an engine available by name to game state requests.
+// It panics if an engine is already registered under the name.
+func RegisterEngine(name string, factory EngineFactory) {
+	enginesMu.Lock()
+	defer enginesMu.Unlock()
+	if factory == nil {
+		panic("game: RegisterEngine factory is nil")
+	}
+	if _, dup := engines[name]; dup {
+		panic("game: RegisterEngine called twice for engine " + name)
+	}
+	engines[name] = factory
+}
+
+// NewEngine creates the engine registered under name. An empty name selects
+// the minimax engine.
+func NewEngine(name string, opts EngineOptions) (Engine, error) {
+	if opts.Iterations < 0 || opts.TimeBudget < 0 {
+		return nil, ErrInvalidSearchLimit
+	}
+	if name == "" {
+		name = EngineMinimax
+	}
+
+	enginesMu.RLock()
+	factory, ok := engines[name]
+	enginesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownEngine, name)
+	}
+	if opts.Rand == nil {
+		opts.Rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return factory(opts), nil
+}
+
+// Engines returns the sorted names of the registered engines.
+func Engines() []string {
+	enginesMu.RLock()
+	defer enginesMu.RUnlock()
+	names := make([]string, 0, len(engines))
+	for name := range engines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// minimaxEngine plays the move found by an iterative deepening alpha-beta
+// search, which searches to the end of the game when the time budget allows.
+type minimaxEngine struct {
+	budget time.Duration
+}
+
+func (e *minimaxEngine) ComputeMove(ctx context.Context, gameState TicTacToeState) (Move, Evaluation, error) {
+	if len(gameState.legalMoves()) == 0 {
+		return Move{}, 0, ErrNoMoves
+	}
+	budget := e.budget
+	if budget == 0 && gameState.variant() == VariantGomoku {
+		budget = gomokuTimeBudget
+	} else if budget == 0 {
+		budget = defaultTimeBudget
+	}
+	score, move, _, err := deepen(ctx, gameState, budget)
+	if err != nil {
+		return Move{}, 0, err
+	}
+
+	return move, Evaluation(score) / scoreWin, nil
+}
+
+// randomEngine plays any legal move.
+type randomEngine struct {
+	rng *rand.Rand
+}
+
+func (e *randomEngine) ComputeMove(ctx context.Context, gameState TicTacToeState) (Move, Evaluation, error) {
+	moves := gameState.legalMoves()
+	if len(moves) == 0 {
+		return Move{}, 0, ErrNoMoves
+	}
+	move := moves[e.rng.Intn(len(moves))]
+	if symbols := gameState.symbols(); len(symbols) > 1 {
+		return Move{X: move[0], Y: move[1], Symbol: symbols[e.rng.Intn(len(symbols))]}, 0, nil
+	}
+
+	return Move{X: move[0], Y: move[1]}, 0, nil
+}
+
+// emptySquares returns the coordinates of every empty square on the board.
+func (t *TicTacToeState) emptySquares() [][2]int {
+	var squares [][2]int
+	for y := range t.Board {
+		for x := range t.Board[y] {
+			if !t.isOccupied(x, y) {
+				squares = append(squares, [2]int{x, y})
+			}
+		}
+	}
+
+	return squares
+}
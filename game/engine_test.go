@@ -0,0 +1,214 @@
+package game
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEngine(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tests := []struct {
+		name      string
+		engine    string
+		opts      EngineOptions
+		expEngine Engine
+		expErr    error
+	}{
+		{
+			name:      "Default",
+			opts:      EngineOptions{Rand: rng},
+			expEngine: &minimaxEngine{},
+		},
+		{
+			name:      "Minimax",
+			engine:    EngineMinimax,
+			opts:      EngineOptions{TimeBudget: time.Second, Rand: rng},
+			expEngine: &minimaxEngine{budget: time.Second},
+		},
+		{
+			name:      "MCTS",
+			engine:    EngineMCTS,
+			opts:      EngineOptions{Iterations: 100, TimeBudget: time.Second, Rand: rng},
+			expEngine: &mctsEngine{iterations: 100, budget: time.Second, rng: rng},
+		},
+		{
+			name:      "Random",
+			engine:    EngineRandom,
+			opts:      EngineOptions{Rand: rng},
+			expEngine: &randomEngine{rng: rng},
+		},
+		{
+			name:      "Beginner",
+			engine:    EngineBeginner,
+			opts:      EngineOptions{Rand: rng},
+			expEngine: &beginnerEngine{rng: rng},
+		},
+		{
+			name:   "Negative iterations",
+			engine: EngineMCTS,
+			opts:   EngineOptions{Iterations: -1},
+			expErr: ErrInvalidSearchLimit,
+		},
+		{
+			name:   "Unknown",
+			engine: "alphazero",
+			expErr: ErrUnknownEngine,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := NewEngine(tt.engine, tt.opts)
+			assert.ErrorIs(t, err, tt.expErr)
+			assert.Equal(t, tt.expEngine, e)
+		})
+	}
+}
+
+type constantEngine struct{}
+
+func (e *constantEngine) ComputeMove(ctx context.Context, gameState TicTacToeState) (Move, Evaluation, error) {
+	return Move{}, 0, nil
+}
+
+func TestRegisterEngine(t *testing.T) {
+	RegisterEngine("constant", func(opts EngineOptions) Engine {
+		return &constantEngine{}
+	})
+	defer func() {
+		enginesMu.Lock()
+		delete(engines, "constant")
+		enginesMu.Unlock()
+	}()
+
+	e, err := NewEngine("constant", EngineOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, &constantEngine{}, e)
+	assert.Contains(t, Engines(), "constant")
+
+	assert.Panics(t, func() {
+		RegisterEngine("constant", func(opts EngineOptions) Engine {
+			return &constantEngine{}
+		})
+	})
+}
+
+func TestEngines(t *testing.T) {
+	assert.Equal(t, []string{EngineBeginner, EngineMCTS, EngineMinimax, EngineRandom}, Engines())
+}
+
+func TestEngine_ComputeMove(t *testing.T) {
+	gameState := TicTacToeState{
+		Turn: 6,
+		Board: [][]SquareState{
+			{SquareStateCross, SquareStateEmpty, SquareStateEmpty},
+			{SquareStateCross, SquareStateCross, SquareStateNaught},
+			{SquareStateEmpty, SquareStateEmpty, SquareStateNaught},
+		},
+	}
+	full := TicTacToeState{
+		Turn: 10,
+		Board: [][]SquareState{
+			{SquareStateCross, SquareStateNaught, SquareStateCross},
+			{SquareStateCross, SquareStateNaught, SquareStateNaught},
+			{SquareStateNaught, SquareStateCross, SquareStateCross},
+		},
+	}
+	for _, name := range Engines() {
+		t.Run(name, func(t *testing.T) {
+			e, err := NewEngine(name, EngineOptions{Iterations: 1000, Rand: rand.New(rand.NewSource(1))})
+			assert.NoError(t, err)
+
+			move, evaluation, err := e.ComputeMove(context.Background(), gameState)
+			assert.NoError(t, err)
+			assert.False(t, gameState.isOccupied(move.X, move.Y))
+			assert.GreaterOrEqual(t, float64(evaluation), -1.0)
+			assert.LessOrEqual(t, float64(evaluation), 1.0)
+
+			_, _, err = e.ComputeMove(context.Background(), full)
+			assert.ErrorIs(t, err, ErrNoMoves)
+		})
+	}
+
+	e := &minimaxEngine{}
+	move, evaluation, err := e.ComputeMove(context.Background(), gameState)
+	assert.NoError(t, err)
+	assert.Equal(t, Move{X: 2, Y: 0}, move)
+	assert.Equal(t, Evaluation(1), evaluation)
+}
+
+func TestMinimaxEngine_Gomoku(t *testing.T) {
+	// gomoku returns a Gomoku board holding crosses and naughts on the given
+	// squares, each given as x, y.
+	gomoku := func(crosses, naughts [][2]int) TicTacToeState {
+		gs := TicTacToeState{Variant: VariantGomoku}
+		assert.NoError(t, gs.initialize())
+		for _, s := range crosses {
+			gs.Board[s[1]][s[0]] = SquareStateCross
+		}
+		for _, s := range naughts {
+			gs.Board[s[1]][s[0]] = SquareStateNaught
+		}
+		gs.Turn = len(crosses) + len(naughts) + 1
+		return gs
+	}
+
+	tests := []struct {
+		name      string
+		budget    time.Duration
+		gameState TicTacToeState
+		expMove   Move
+	}{
+		{
+			name: "Completes five",
+			gameState: gomoku(
+				[][2]int{{5, 7}, {6, 7}, {7, 7}, {8, 7}},
+				[][2]int{{4, 7}, {6, 6}, {7, 8}, {10, 10}},
+			),
+			expMove: Move{X: 9, Y: 7},
+		},
+		{
+			name:   "Blocks four",
+			budget: 200 * time.Millisecond,
+			gameState: gomoku(
+				[][2]int{{5, 7}, {6, 7}, {7, 7}, {8, 7}},
+				[][2]int{{4, 7}, {6, 6}, {7, 8}},
+			),
+			expMove: Move{X: 9, Y: 7},
+		},
+		{
+			name:      "Opening",
+			gameState: gomoku(nil, nil),
+			expMove:   Move{X: 7, Y: 7},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &minimaxEngine{budget: tt.budget}
+			move, _, err := e.ComputeMove(context.Background(), tt.gameState)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expMove, move)
+		})
+	}
+}
+
+func TestMinimaxEngine_GomokuStopsAtBudget(t *testing.T) {
+	gs := TicTacToeState{Variant: VariantGomoku}
+	assert.NoError(t, gs.initialize())
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 4; i++ {
+		_ = gs.occupyPosition(5+rng.Intn(5), 5+rng.Intn(5))
+	}
+
+	// The search is far from solving the position when the budget runs out,
+	// and plays the best move it found by then.
+	s := newSearch(context.Background(), gs, gomokuTimeBudget)
+	_, move, solved, err := s.deepen(gs)
+	assert.NoError(t, err)
+	assert.True(t, s.aborted)
+	assert.False(t, solved)
+	assert.False(t, gs.isOccupied(move.X, move.Y))
+}
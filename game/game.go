@@ -1,13 +1,14 @@
 package game
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
+	"math/rand"
 	"net/http"
+	"time"
 
 	"github.com/julienschmidt/httprouter"
 )
@@ -17,6 +18,11 @@ const (
 	SquareStateEmpty  SquareState = 0
 	SquareStateCross  SquareState = 'X'
 	SquareStateNaught SquareState = '0'
+	// SquareStateDelta is the third player's piece in a multiplayer game
+	// when the request does not choose the symbols.
+	SquareStateDelta SquareState = 'Δ'
+	// SquareStateBlocked is a square that nobody may play on.
+	SquareStateBlocked SquareState = '#'
 )
 
 type Result int
@@ -24,25 +30,175 @@ const (
 	ResultNone      Result = iota
 	ResultNInARow   Result = iota
 	ResultStalemate Result = iota
+	// ResultMisereLoss is a completed line in the misère variant, which the
+	// player who completed it loses.
+	ResultMisereLoss Result = iota
+	// ResultRepetition is a draw by the same position coming up for the
+	// third time, in the infinite variant where the board never fills up.
+	ResultRepetition Result = iota
 )
 
+// Variant selects the rules the game is played by.
+type Variant string
+
+const (
+	// VariantStandard is won by the first player to complete a line.
+	VariantStandard Variant = "standard"
+	// VariantMisere is lost by the first player to complete a line.
+	VariantMisere Variant = "misere"
+	// VariantQubic is played on a 4x4x4 cube, described by a QubicState, and
+	// won by the first player to complete a line of four.
+	VariantQubic Variant = "qubic"
+	// VariantUltimate is played on nine sub-boards, described by an
+	// UltimateState, and won by the first player to win three sub-boards in
+	// a line.
+	VariantUltimate Variant = "ultimate"
+	// VariantGomoku is five in a row on a 15x15 board unless the request
+	// says otherwise.
+	VariantGomoku Variant = "gomoku"
+	// VariantGravity drops each piece to the lowest empty square of the
+	// column it is played in, four in a row on a board seven columns wide
+	// and six rows high unless the request says otherwise.
+	VariantGravity Variant = "gravity"
+	// VariantWild lets either player place either symbol, and is won by
+	// the player who completes a line, whichever symbol it is made of.
+	VariantWild Variant = "wild"
+	// VariantNotakto is played on several 3x3 boards, described by a
+	// NotaktoState, on which both players place crosses and the player who
+	// completes a line on the last board still in play loses.
+	VariantNotakto Variant = "notakto"
+	// VariantOrderChaos is played on a 6x6 board unless the request says
+	// otherwise, on which both players place either symbol. Order moves
+	// first and wins by making five in a row of either symbol, and Chaos
+	// wins by filling the board without that happening.
+	VariantOrderChaos Variant = "orderchaos"
+	// VariantToroidal is won by the first player to complete a line, as
+	// in the standard variant, but on a board whose edges join up, so that
+	// a line running off one edge carries on from the opposite one.
+	VariantToroidal Variant = "toroidal"
+	// VariantNumerical is played on a 3x3 board, described by a
+	// NumericalState, on which one player places odd numbers and the other
+	// even ones, and won by the first player to complete a line adding up
+	// to 15.
+	VariantNumerical Variant = "numerical"
+	// VariantMultiplayer is played by two or more players, described by a
+	// MultiplayerState, who take turns in a given order, and is won by the
+	// first of them to complete a line.
+	VariantMultiplayer Variant = "multiplayer"
+	// VariantInfinite is played on a 3x3 board, described by an
+	// InfiniteState, on which each player keeps at most three pieces, their
+	// oldest piece disappearing when they place a fourth.
+	VariantInfinite Variant = "infinite"
+	// VariantMorris is Three Men's Morris, played on a 3x3 board,
+	// described by a MorrisState, on which each player places three pieces
+	// and then slides them from square to square until one of them
+	// completes a line.
+	VariantMorris Variant = "morris"
+)
+
+// Role is the part a player takes in a game in which the players have
+// different aims, such as Order and Chaos.
+type Role string
+
+const (
+	RoleOrder Role = "order"
+	RoleChaos Role = "chaos"
+)
+
+// defaultBoardSize is the size of the board when a request specifies neither
+// a board nor a size.
+const defaultBoardSize = 3
+
+// maxBoardSize is the largest board a request may specify.
+const maxBoardSize = 19
+
+// Board size and win length of the Gomoku variant when the request does not
+// give them.
+const (
+	gomokuBoardSize = 15
+	gomokuWinLength = 5
+)
+
+// Board size and win length of the Order and Chaos variant when the request
+// does not give them.
+const (
+	orderChaosBoardSize = 6
+	orderChaosWinLength = 5
+)
+
+// Board width, height and win length of the gravity variant when the request
+// does not give them.
+const (
+	gravityWidth     = 7
+	gravityHeight    = 6
+	gravityWinLength = 4
+)
+
+// TicTacToeState is an N by N board on which the first player to complete a
+// line of WinLength squares wins, or loses when playing the misère Variant.
+// The computer plays the side whose turn it is, unless the request says which
+// side the human plays.
 type TicTacToeState struct {
 	Board [][]SquareState `json:"board"`
-	Turn  int             `json:"-"`
+	Size  int             `json:"size,omitempty"`
+	// Width and Height give the gravity variant's board, in which row zero
+	// is the top, and Column is the human's move there, played before the
+	// computer's.
+	Width  int  `json:"width,omitempty"`
+	Height int  `json:"height,omitempty"`
+	Column *int `json:"column,omitempty"`
+	// WinLength is zero when a line must span the whole board.
+	WinLength int `json:"winLength,omitempty"`
+	// NoOverlines stops lines longer than WinLength from counting.
+	NoOverlines bool    `json:"noOverlines,omitempty"`
+	Variant     Variant `json:"variant,omitempty"`
+	// FirstPlayer opens the game, crosses when it is not set.
+	FirstPlayer SquareState `json:"firstPlayer,omitempty"`
+	HumanPlayer SquareState `json:"humanPlayer,omitempty"`
+	// HumanRole takes the place of HumanPlayer in Order and Chaos, where the
+	// players are chosen by role rather than symbol.
+	HumanRole     Role       `json:"humanRole,omitempty"`
+	Difficulty    Difficulty `json:"difficulty,omitempty"`
+	MistakeChance *float64   `json:"mistakeChance,omitempty"`
+	Engine        string     `json:"engine,omitempty"`
+	Iterations    int        `json:"iterations,omitempty"`
+	TimeBudget    int        `json:"timeBudget,omitempty"` // milliseconds
+	Seed          int64      `json:"seed,omitempty"`
+	// Blocked squares may not be played on, and the Handicap pieces, each a
+	// square and the Symbol of its player, are on the board before the first
+	// move. Neither counts as a move, and both are put on the board unless it
+	// already shows them. Under gravity, blocked squares rest on the bottom
+	// row or on another square that is not empty, like pieces.
+	Blocked  []Square `json:"blocked,omitempty"`
+	Handicap []Move   `json:"handicap,omitempty"`
+	Turn     int      `json:"-"`
+	// setup is the number of squares taken before the first move, blocked
+	// or holding handicap pieces.
+	setup int
 }
 
 type TicTacToeStateResponse struct {
 	Board      [][]SquareState   `json:"board"`
 	Result     Result            `json:"result,omitempty"`
 	WinningRow [][]SquareState   `json:"winningRow,omitempty"`
+	Winner     rune              `json:"winner,omitempty"`
+	Loser      rune              `json:"loser,omitempty"`
 	Turn       int               `json:"turn"`
 	NextPlayer rune              `json:"nextPlayer"`
+	WinLength  int               `json:"winLength"`
+	// WinningRole and NextRole take the place of Winner and NextPlayer in
+	// games played by role.
+	WinningRole Role `json:"winningRole,omitempty"`
+	NextRole    Role `json:"nextRole,omitempty"`
 }
 
 // TicTacToeStateHandler accepts a TicTacToeState representing the
 // current state of the game and responds with a TicTacToeStateResponse
-// describing the new state of the game.
+// describing the new state of the game. The computer's move is chosen by the
+// engine named in the request, played at the requested difficulty.
 func TicTacToeStateHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	start := time.Now()
+
 	// Parapgraph #1
 	b, err := ioutil.ReadAll(r.Body)
 	if err != nil {
@@ -51,22 +207,119 @@ func TicTacToeStateHandler(w http.ResponseWriter, r *http.Request, ps httprouter
 	}
 
 	// Parapgraph #2
-	req := &TicTacToeState{
-		Board: makeBoard(3),
+	variant := struct {
+		Variant Variant `json:"variant"`
+		requestOptions
+	}{}
+	err = json.Unmarshal(b, &variant)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "could not interpret request", err)
+		return
+	}
+	err = variant.requestOptions.check(variant.Variant)
+	if err != nil {
+		writeHTTPError(w, errorStatus(err), "invalid game settings", err)
+		return
 	}
+	switch variant.Variant {
+	case VariantQubic:
+		qubicStateHandler(w, r, b)
+		return
+	case VariantUltimate:
+		ultimateStateHandler(w, r, b)
+		return
+	case VariantNotakto:
+		notaktoStateHandler(w, r, b)
+		return
+	case VariantNumerical:
+		numericalStateHandler(w, r, b)
+		return
+	case VariantMultiplayer:
+		multiplayerStateHandler(w, r, b)
+		return
+	case VariantInfinite:
+		infiniteStateHandler(w, r, b)
+		return
+	case VariantMorris:
+		morrisStateHandler(w, r, b)
+		return
+	}
+
+	req := &TicTacToeState{}
 	err = json.Unmarshal(b, req)
 	if err != nil {
 		writeHTTPError(w, http.StatusBadRequest, "could not interpret request", err)
 		return
 	}
 
-	req.initialize()
+	err = req.initialize()
+	if err != nil {
+		writeHTTPError(w, errorStatus(err), "invalid game settings", err)
+		return
+	}
+
+	seed := req.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	opts := EngineOptions{
+		Iterations: req.Iterations,
+		TimeBudget: time.Duration(req.TimeBudget) * time.Millisecond,
+		Rand:       rand.New(rand.NewSource(seed)),
+	}
+	engine, err := NewEngine(req.Engine, opts)
+	if err != nil {
+		writeHTTPError(w, errorStatus(err), "invalid engine", err)
+		return
+	}
+	engine, err = withDifficulty(req.Difficulty, req.MistakeChance, engine, opts.Rand)
+	if err != nil {
+		writeHTTPError(w, errorStatus(err), "invalid difficulty", err)
+		return
+	}
 
 	// Parapgraph #3
 	result, _ := req.getGameResult()
+	if req.Column != nil {
+		if req.variant() != VariantGravity {
+			writeHTTPError(w, errorStatus(ErrColumnOutsideGravity), "invalid move", ErrColumnOutsideGravity)
+			return
+		}
+		if result != ResultNone {
+			writeHTTPError(w, errorStatus(ErrGameOver), "invalid move", ErrGameOver)
+			return
+		}
+		if isPlayer(req.HumanPlayer) && SquareState(req.playersTurn()) != req.HumanPlayer {
+			writeHTTPError(w, errorStatus(ErrNotHumansTurn), "invalid move", ErrNotHumansTurn)
+			return
+		}
+		_, err = req.dropPiece(*req.Column)
+		if err != nil {
+			writeHTTPError(w, errorStatus(err), "invalid move", err)
+			return
+		}
+		result, _ = req.getGameResult()
+	}
+	if result == ResultNone && (SquareState(req.playersTurn()) == req.HumanPlayer ||
+		req.HumanRole != "" && req.playersRole() == req.HumanRole) {
+		writeHTTPError(w, errorStatus(ErrNotComputersTurn), "invalid game state", ErrNotComputersTurn)
+		return
+	}
 	if result == ResultNone {
-		_, x, y := computeMove(*req, true)
-		err = req.occupyPosition(x, y)
+		// The Gomoku budget counts from the request's arrival, so that the
+		// computer answers within a second however long reading it took.
+		ctx := r.Context()
+		if req.variant() == VariantGomoku && req.TimeBudget == 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithDeadline(ctx, start.Add(gomokuTimeBudget))
+			defer cancel()
+		}
+		move, _, err := engine.ComputeMove(ctx, *req)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to compute move", err)
+			return
+		}
+		err = req.placeSymbol(move.X, move.Y, move.Symbol)
 		if err != nil {
 			writeHTTPError(w, http.StatusInternalServerError, "failed to set board", err)
 			return
@@ -81,6 +334,17 @@ func TicTacToeStateHandler(w http.ResponseWriter, r *http.Request, ps httprouter
 		Turn:       req.Turn,
 		WinningRow: winningRow,
 		NextPlayer: req.playersTurn(),
+		WinLength:  req.WinLength,
+	}
+	if req.variant() == VariantOrderChaos {
+		resp.NextPlayer = 0
+		resp.NextRole = req.playersRole()
+		resp.WinningRole = req.role(req.winner(result, winningRow))
+	} else if result == ResultNInARow {
+		resp.Winner = req.winner(result, winningRow)
+	}
+	if result == ResultMisereLoss {
+		resp.Loser = linePlayer(winningRow)
 	}
 
 	// Parapgraph #5
@@ -93,25 +357,30 @@ func TicTacToeStateHandler(w http.ResponseWriter, r *http.Request, ps httprouter
 	// Parapgraph #6
 	_, err = w.Write(b)
 	if err != nil {
-		log.Fatal(err)
-		return
+		log.Print(err)
 	}
 }
 
 func writeHTTPError(w http.ResponseWriter, statusCode int, description string, err error) {
 	message := fmt.Sprintf("%s : %v", description, err)
+	log.Print(message)
+	w.WriteHeader(statusCode)
 	_, wErr := w.Write([]byte(message))
 	if wErr != nil {
-		log.Fatal(wErr)
+		log.Print(wErr)
 	}
-	log.Fatal(description, err)
-	w.WriteHeader(statusCode)
 }
 
 func makeBoard(n int) [][]SquareState {
-	board := make([][]SquareState, n)
-	for i := 0; i < n; i++ {
-		board[i] = make([]SquareState, n)
+	return makeRectangle(n, n)
+}
+
+// makeRectangle returns an empty board width columns wide and height rows
+// high.
+func makeRectangle(width, height int) [][]SquareState {
+	board := make([][]SquareState, height)
+	for i := 0; i < height; i++ {
+		board[i] = make([]SquareState, width)
 	}
 
 	return board
@@ -121,32 +390,309 @@ func copyBoard(src [][]SquareState) [][]SquareState {
 	n := len(src)
 	board := make([][]SquareState, n)
 	for j := 0; j < n; j++ {
-		board[j] = make([]SquareState, n)
+		board[j] = make([]SquareState, len(src[j]))
 		copy(board[j], src[j])
 	}
 
 	return board
 }
 
-func (t *TicTacToeState) initialize() {
-	turn := 1
+// initialize prepares a game state received in a request, creating an empty
+// board of the requested size when no board was given, and checking that the
+// board size and win length are playable and that the board shows a position
+// play could lead to.
+func (t *TicTacToeState) initialize() error {
+	var err error
+	switch t.variant() {
+	case VariantStandard, VariantMisere, VariantWild, VariantToroidal:
+	case VariantGomoku:
+		if len(t.Board) == 0 && t.Size == 0 {
+			t.Size = gomokuBoardSize
+		}
+		if t.WinLength == 0 {
+			t.WinLength = gomokuWinLength
+		}
+	case VariantGravity:
+		if t.WinLength == 0 {
+			t.WinLength = gravityWinLength
+		}
+	case VariantOrderChaos:
+		if len(t.Board) == 0 && t.Size == 0 {
+			t.Size = orderChaosBoardSize
+		}
+		if t.WinLength == 0 {
+			t.WinLength = orderChaosWinLength
+		}
+		if t.FirstPlayer != SquareStateEmpty || t.HumanPlayer != SquareStateEmpty {
+			return ErrPlayersByRole
+		}
+	default:
+		return ErrUnknownVariant
+	}
+
+	if t.variant() == VariantGravity {
+		err = t.initializeRectangle()
+	} else {
+		err = t.initializeSquare()
+	}
+	if err != nil {
+		return err
+	}
+	if !isPlayer(t.FirstPlayer) && t.FirstPlayer != SquareStateEmpty ||
+		!isPlayer(t.HumanPlayer) && t.HumanPlayer != SquareStateEmpty {
+		return ErrInvalidPlayer
+	}
+	if t.HumanRole != "" && (t.variant() != VariantOrderChaos || t.HumanRole != RoleOrder && t.HumanRole != RoleChaos) {
+		return ErrInvalidRole
+	}
+
+	err = t.validateSymbols()
+	if err != nil {
+		return err
+	}
+	err = t.applySetup()
+	if err != nil {
+		return err
+	}
+
+	// Handicap pieces are on the board but were never played.
+	turn := 1 - len(t.Handicap)
+	first, second, blocked := 0, 0, 0
 	for _, y := range t.Board {
 		for _, x := range y {
-			if x != SquareStateEmpty {
+			if x == SquareStateBlocked {
+				blocked++
+			} else if x != SquareStateEmpty {
 				turn++
 			}
+			if x == t.firstPlayer() {
+				first++
+			} else if x == t.secondPlayer() {
+				second++
+			}
+		}
+	}
+	for _, piece := range t.Handicap {
+		if piece.Symbol == t.firstPlayer() {
+			first--
+		} else {
+			second--
 		}
 	}
+	if !t.sharesSymbols() && first != second && first != second+1 {
+		return ErrTurnImbalance
+	}
+	if t.variant() == VariantGravity && !t.isSettled() {
+		return ErrFloatingPiece
+	}
+	err = t.validatePosition(first, second)
+	if err != nil {
+		return err
+	}
 
 	t.Turn = turn
+	t.setup = blocked + len(t.Handicap)
+
+	return nil
+}
+
+// applySetup puts the Blocked squares and Handicap pieces of a custom setup on
+// the board, checking that each is on a square of its own that is either
+// empty or already shows it.
+func (t *TicTacToeState) applySetup() error {
+	taken := map[Square]bool{}
+	place := func(x, y int, s SquareState) error {
+		if y < 0 || y >= len(t.Board) || x < 0 || x >= len(t.Board[y]) {
+			return ErrOutOfBounds
+		}
+		if taken[Square{X: x, Y: y}] {
+			return ErrInvalidSetup
+		}
+		if t.Board[y][x] != SquareStateEmpty && t.Board[y][x] != s {
+			return ErrInvalidSetup
+		}
+		taken[Square{X: x, Y: y}] = true
+		t.Board[y][x] = s
+
+		return nil
+	}
+
+	for _, square := range t.Blocked {
+		if err := place(square.X, square.Y, SquareStateBlocked); err != nil {
+			return err
+		}
+	}
+	for _, piece := range t.Handicap {
+		if !isPlayer(piece.Symbol) {
+			return ErrInvalidSetup
+		}
+		if err := place(piece.X, piece.Y, piece.Symbol); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// initializeSquare checks the size of an N by N board, creating it when no
+// board was given.
+func (t *TicTacToeState) initializeSquare() error {
+	if len(t.Board) == 0 {
+		if t.Size == 0 {
+			t.Size = defaultBoardSize
+		}
+		if t.Size < 1 || t.Size > maxBoardSize {
+			return ErrInvalidBoardSize
+		}
+		t.Board = makeBoard(t.Size)
+	}
+	if t.Size != 0 && t.Size != len(t.Board) || len(t.Board) > maxBoardSize {
+		return ErrInvalidBoardSize
+	}
+	for _, row := range t.Board {
+		if len(row) != len(t.Board) {
+			return ErrInvalidBoardSize
+		}
+	}
+	t.Size = len(t.Board)
+	if t.WinLength == 0 {
+		t.WinLength = t.Size
+	}
+	if t.WinLength < 1 || t.WinLength > t.Size {
+		return ErrInvalidWinLength
+	}
+
+	return nil
+}
+
+// initializeRectangle checks the size of a board Width columns wide and
+// Height rows high, creating it when no board was given. A Size stands for
+// whichever of the two is not given.
+func (t *TicTacToeState) initializeRectangle() error {
+	if t.Width == 0 {
+		t.Width = t.Size
+	}
+	if t.Height == 0 {
+		t.Height = t.Size
+	}
+	if len(t.Board) == 0 {
+		if t.Width == 0 {
+			t.Width = gravityWidth
+		}
+		if t.Height == 0 {
+			t.Height = gravityHeight
+		}
+		if t.Width < 1 || t.Width > maxBoardSize || t.Height < 1 || t.Height > maxBoardSize {
+			return ErrInvalidBoardSize
+		}
+		t.Board = makeRectangle(t.Width, t.Height)
+	}
+	width := len(t.Board[0])
+	for _, row := range t.Board {
+		if len(row) != width {
+			return ErrInvalidBoardSize
+		}
+	}
+	if t.Width != 0 && t.Width != width || t.Height != 0 && t.Height != len(t.Board) ||
+		width < 1 || width > maxBoardSize || len(t.Board) > maxBoardSize {
+		return ErrInvalidBoardSize
+	}
+	t.Width, t.Height, t.Size = width, len(t.Board), 0
+	if t.WinLength < 1 || t.WinLength > t.Width && t.WinLength > t.Height {
+		return ErrInvalidWinLength
+	}
+
+	return nil
+}
+
+// clone returns a copy of the game state with its own board.
+func (t *TicTacToeState) clone() TicTacToeState {
+	return TicTacToeState{
+		Board:       copyBoard(t.Board),
+		Width:       t.Width,
+		Height:      t.Height,
+		WinLength:   t.WinLength,
+		NoOverlines: t.NoOverlines,
+		Variant:     t.Variant,
+		FirstPlayer: t.FirstPlayer,
+		Turn:        t.Turn,
+		setup:       t.setup,
+	}
+}
+
+func isPlayer(s SquareState) bool {
+	return s == SquareStateCross || s == SquareStateNaught
+}
+
+// firstPlayer returns the player who opens the game.
+func (t *TicTacToeState) firstPlayer() SquareState {
+	return opener(t.FirstPlayer)
+}
+
+// opener returns the player who opens a game that the request says first
+// opens, crosses unless it says naughts.
+func opener(first SquareState) SquareState {
+	if first == SquareStateNaught {
+		return SquareStateNaught
+	}
+
+	return SquareStateCross
+}
+
+// opponent returns the other player.
+func opponent(player SquareState) SquareState {
+	if player == SquareStateCross {
+		return SquareStateNaught
+	}
+
+	return SquareStateCross
+}
+
+// order returns 1 for the first player's pieces, 2 for the second player's
+// pieces and 0 for anything else.
+func (t *TicTacToeState) order(s SquareState) int {
+	switch s {
+	case SquareStateEmpty:
+		return 0
+	case t.firstPlayer():
+		return 1
+	case t.secondPlayer():
+		return 2
+	}
+
+	return 0
+}
+
+// secondPlayer returns the player who replies to the opening move.
+func (t *TicTacToeState) secondPlayer() SquareState {
+	return opponent(t.firstPlayer())
 }
 
 func (t *TicTacToeState) playersTurn() rune {
 	if t.Turn % 2 == 1 {
-		return 'X'
+		return rune(t.firstPlayer())
+	}
+
+	return rune(t.secondPlayer())
+}
+
+// playersRole returns the role of the player whose turn it is in a game
+// played by role.
+func (t *TicTacToeState) playersRole() Role {
+	return t.role(t.playersTurn())
+}
+
+// role returns the role of player in a game played by role, Order for the
+// first player and Chaos for the second, or nothing for anyone else.
+func (t *TicTacToeState) role(player rune) Role {
+	switch SquareState(player) {
+	case t.firstPlayer():
+		return RoleOrder
+	case t.secondPlayer():
+		return RoleChaos
 	}
 
-	return '0'
+	return ""
 }
 
 func (t *TicTacToeState) isOccupied(x, y int) bool {
@@ -154,123 +700,230 @@ func (t *TicTacToeState) isOccupied(x, y int) bool {
 }
 
 func (t *TicTacToeState) occupyPosition(x, y int) error {
-	if x < 0 || x > len(t.Board) || y < 0 || y > len(t.Board) {
-		return errors.New("invalid coordinate")
+	return t.placeSymbol(x, y, SquareStateEmpty)
+}
+
+// placeSymbol occupies x, y for the player whose turn it is with symbol, or
+// with their own symbol when symbol is empty. Only in the wild variant may
+// players place their opponent's symbol.
+func (t *TicTacToeState) placeSymbol(x, y int, symbol SquareState) error {
+	player := SquareState(t.playersTurn())
+	if symbol == SquareStateEmpty {
+		symbol = player
+	}
+	if !isPlayer(symbol) || symbol != player && !t.sharesSymbols() {
+		return ErrInvalidSymbol
+	}
+	if y < 0 || y >= len(t.Board) || x < 0 || x >= len(t.Board[y]) {
+		return ErrOutOfBounds
 	}
 	if t.Board[y][x] != SquareStateEmpty {
-		return errors.New("already occupied")
+		return ErrOccupied
+	}
+	if t.variant() == VariantGravity && y != t.dropRow(x) {
+		return ErrIllegalMove
 	}
 
-	player := t.playersTurn()
 	t.Turn++
-	if player == 'X' {
-		t.Board[y][x] = SquareStateCross
-		return nil
-	}
-	t.Board[y][x] = SquareStateNaught
+	t.Board[y][x] = symbol
 
 	return nil
 }
 
+// sharesSymbols reports whether the players may place either symbol, as in the
+// wild and Order and Chaos variants, rather than each having their own.
+func (t *TicTacToeState) sharesSymbols() bool {
+	return t.variant() == VariantWild || t.variant() == VariantOrderChaos
+}
+
+// symbols returns the symbols the player whose turn it is may place: either
+// when the players share them, otherwise only their own.
+func (t *TicTacToeState) symbols() []SquareState {
+	if t.sharesSymbols() {
+		return []SquareState{SquareStateCross, SquareStateNaught}
+	}
+
+	return []SquareState{SquareState(t.playersTurn())}
+}
+
+// winner returns the player who won a game that ended in result, concluded
+// by row, or zero when nobody did. A completed line wins for the player who
+// completed it, which in the wild variant is whoever moved last rather than
+// the player whose symbol makes up the line. In Order and Chaos a line wins
+// for Order, the first player, and a full board for Chaos.
+func (t *TicTacToeState) winner(result Result, row [][]SquareState) rune {
+	switch {
+	case result == ResultNInARow && t.variant() == VariantOrderChaos:
+		return rune(t.firstPlayer())
+	case result == ResultStalemate && t.variant() == VariantOrderChaos:
+		return rune(t.secondPlayer())
+	case result == ResultNInARow && t.variant() == VariantWild:
+		return rune(opponent(SquareState(t.playersTurn())))
+	case result == ResultNInARow:
+		return linePlayer(row)
+	case result == ResultMisereLoss:
+		// The player who completed the line lost to the player whose turn
+		// it now is.
+		return t.playersTurn()
+	}
+
+	return 0
+}
+
+// lineDirections are the directions lines run in: diagonals, anti-diagonals,
+// columns and rows, in the order getGameResult checks them.
+var lineDirections = [][2]int{{1, 1}, {-1, 1}, {0, 1}, {1, 0}}
+
 // getGameResult calculates the current state of the game returning the result
 //  and the row that concluded the game if there is a complete row, nil otherwise.
+// The player whose pieces make up the row won, or in the misère variant lost.
 func (t *TicTacToeState) getGameResult() (Result, [][]SquareState) {
 	n := len(t.Board)
-	var rowOfN [][]SquareState
-
-	// Check diagonal
-	rowOfN = makeBoard(n)
-	var i int
-	for i = 0; i < n - 1 && t.Board[i][i] == t.Board[i+1][i+1] && t.Board[i][i] != SquareStateEmpty; i++ {
-		rowOfN[i][i] = t.Board[i][i]
-		rowOfN[i+1][i+1] = t.Board[i+1][i+1]
-	}
-	if i == n - 1 {
-		return ResultNInARow, rowOfN
+	k := t.winLength()
+
+	for _, d := range lineDirections {
+		for y := 0; y < n; y++ {
+			for x := 0; x < len(t.Board[y]); x++ {
+				if t.isLine(x, y, d[0], d[1], k) {
+					return t.lineResult(x, y, d[0], d[1], k)
+				}
+			}
+		}
 	}
 
-	// Check anti-diagonal
-	rowOfN = makeBoard(n)
-	for i = 0; i < n - 1 && t.Board[n-1-i][i] == t.Board[n-i-2][i+1] && t.Board[n-i-2][i+1] != SquareStateEmpty; i++ {
-		rowOfN[n-1-i][i] = t.Board[n-1-i][i]
-		rowOfN[n-i-2][i+1] = t.Board[n-i-2][i+1]
-	}
-	if i == n - 1 {
-		return ResultNInARow, rowOfN
+	if t.isFull() {
+		return ResultStalemate, nil
 	}
 
-	var j int
-	for j = 0; j < n; j++ {
-		// Check columns
-		rowOfN = makeBoard(n)
-		for i = 0; i < n-1 && t.Board[i][j] == t.Board[i+1][j] && t.Board[i][j] != SquareStateEmpty; i++ {
-			rowOfN[i][j] = t.Board[i][j]
-			rowOfN[i+1][j] = t.Board[i+1][j]
-		}
-		if i == n-1 {
-			return ResultNInARow, rowOfN
-		}
+	return ResultNone, nil
+}
 
-		// Check rows
-		rowOfN = makeBoard(n)
-		for i = 0; i < n-1 && t.Board[j][i] == t.Board[j][i+1] && t.Board[j][i] != SquareStateEmpty; i++ {
-			rowOfN[j][i] = t.Board[j][i]
-			rowOfN[j][i+1] = t.Board[j][i+1]
+// moveResult is getGameResult for a game that was still in play before the
+// move just made at x, y. Only the lines through x, y can have been completed
+// by it, so rather than scanning the whole board it looks along each
+// direction from the start of the run of pieces through x, y.
+func (t *TicTacToeState) moveResult(x, y int) (Result, [][]SquareState) {
+	k := t.winLength()
+	player := t.Board[y][x]
+	for _, d := range lineDirections {
+		sx, sy := x, y
+		// On a toroidal board a run of pieces can go all the way round, so
+		// the walk back stops after a lap.
+		for steps := 1; t.holds(sx-d[0], sy-d[1], player) && (!t.wraps() || steps < len(t.Board)); steps++ {
+			sx, sy = t.wrap(sx-d[0], sy-d[1])
 		}
-		if i == n-1 {
-			return ResultNInARow, rowOfN
+		if t.isLine(sx, sy, d[0], d[1], k) {
+			return t.lineResult(sx, sy, d[0], d[1], k)
 		}
 	}
 
-	// Check for stalemate
-	if t.Turn > n * n {
+	if t.isFull() {
 		return ResultStalemate, nil
 	}
 
 	return ResultNone, nil
 }
 
-func computeMove(gameState TicTacToeState, isMax bool) (int, int, int) {
-	optimalX := 0
-	optimalY := 0
-	multiplier := 1
-	if !isMax {
-		multiplier = -1
+// lineResult returns the result of the line of k squares starting at x, y and
+// stepping by dx, dy, along with the row made up of it.
+func (t *TicTacToeState) lineResult(x, y, dx, dy, k int) (Result, [][]SquareState) {
+	rowOfN := makeRectangle(len(t.Board[0]), len(t.Board))
+	for i := 0; i < k; i++ {
+		px, py := t.wrap(x+i*dx, y+i*dy)
+		rowOfN[py][px] = t.Board[y][x]
+	}
+	if t.variant() == VariantMisere {
+		return ResultMisereLoss, rowOfN
 	}
-	threshold := math.MaxInt32 * -1 * multiplier
 
-	for y := 0; y < 3; y++ {
-		for x := 0; x < 3; x++ {
-			if gameState.isOccupied(x, y) {
-				continue
-			}
+	return ResultNInARow, rowOfN
+}
 
-			gs := TicTacToeState{
-				Board: copyBoard(gameState.Board),
-				Turn:  gameState.Turn,
-			}
+// isFull reports whether every square has been played, leaving aside those
+// taken before the first move.
+func (t *TicTacToeState) isFull() bool {
+	n := len(t.Board)
+	return n > 0 && t.Turn+t.setup > n*len(t.Board[0])
+}
 
-			err := gs.occupyPosition(x, y)
-			if err != nil {
-				log.Fatal(err)
-				continue
-			}
-			result, _ := gs.getGameResult()
-			if result == ResultNInARow {
-				return 1 * multiplier, x, y
-			} else if result == ResultStalemate {
-				return 0, x, y
+// linePlayer returns the player whose pieces make up the row returned by
+// getGameResult.
+func linePlayer(row [][]SquareState) rune {
+	for _, squares := range row {
+		for _, square := range squares {
+			if square != SquareStateEmpty {
+				return rune(square)
 			}
+		}
+	}
+
+	return 0
+}
 
-			r, _, _ := computeMove(gs, !isMax)
+// variant returns the rules the game is played by.
+func (t *TicTacToeState) variant() Variant {
+	if t.Variant == "" {
+		return VariantStandard
+	}
 
-			if (isMax && r > threshold) || (!isMax && r < threshold) {
-				threshold = r
-				optimalX = x
-				optimalY = y
-			}
+	return t.Variant
+}
+
+// winLength returns the number of squares in a row needed to win.
+func (t *TicTacToeState) winLength() int {
+	if t.WinLength > 0 {
+		return t.WinLength
+	}
+
+	return len(t.Board)
+}
+
+// isLine reports whether the k squares starting at x, y and stepping by dx, dy
+// all hold the same player's piece, and when overlines do not count, whether
+// the squares either side of them do not.
+func (t *TicTacToeState) isLine(x, y, dx, dy, k int) bool {
+	player := t.Board[y][x]
+	if player == SquareStateEmpty || player == SquareStateBlocked {
+		return false
+	}
+	for i := 1; i < k; i++ {
+		if !t.holds(x+i*dx, y+i*dy, player) {
+			return false
 		}
 	}
+	// A line that goes all the way round a toroidal board has no squares
+	// either side of it but its own.
+	lap := t.wraps() && k >= len(t.Board)
+	if t.NoOverlines && !lap && (t.holds(x-dx, y-dy, player) || t.holds(x+k*dx, y+k*dy, player)) {
+		return false
+	}
+
+	return true
+}
+
+// holds reports whether x, y is on the board and holds the player's piece.
+func (t *TicTacToeState) holds(x, y int, player SquareState) bool {
+	x, y = t.wrap(x, y)
+	if y < 0 || y >= len(t.Board) || x < 0 || x >= len(t.Board[y]) {
+		return false
+	}
+
+	return t.Board[y][x] == player
+}
+
+// wraps reports whether lines carry on across the edges of the board.
+func (t *TicTacToeState) wraps() bool {
+	return t.variant() == VariantToroidal
+}
+
+// wrap returns the square x, y lands on once a line running off the edge of a
+// toroidal board carries on from the opposite edge, and elsewhere x, y as it
+// is.
+func (t *TicTacToeState) wrap(x, y int) (int, int) {
+	if !t.wraps() || len(t.Board) == 0 {
+		return x, y
+	}
+	width, height := len(t.Board[0]), len(t.Board)
 
-	return threshold, optimalX, optimalY
+	return (x%width + width) % width, (y%height + height) % height
 }
@@ -1,8 +1,16 @@
 package game
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -60,6 +68,27 @@ func TestGame_SetBoard(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "Naughts Open",
+			initialGameState:  TicTacToeState{
+				Board:       makeBoard(3),
+				FirstPlayer: SquareStateNaught,
+				Turn:        1,
+			},
+			args:    args{
+				x:      1,
+				y:      1,
+			},
+			expGameState: TicTacToeState{
+				FirstPlayer: SquareStateNaught,
+				Turn:        2,
+				Board: [][]SquareState{
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateNaught, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				},
+			},
+		},
 		{
 			name:    "Out of bounds",
 			args:    args{
@@ -67,7 +96,7 @@ func TestGame_SetBoard(t *testing.T) {
 				y:      0,
 			},
 			expGameState: TicTacToeState{},
-			expErr:       errors.New("invalid coordinate"),
+			expErr:       ErrOutOfBounds,
 		},
 		{
 			name:    "Already occupied",
@@ -83,14 +112,14 @@ func TestGame_SetBoard(t *testing.T) {
 				Board: [][]SquareState{{SquareStateNaught}},
 				Turn: 2,
 			},
-			expErr: errors.New("already occupied"),
+			expErr: ErrOccupied,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := &tt.initialGameState
 			err := g.occupyPosition(tt.args.x, tt.args.y)
-			assert.Equal(t, tt.expErr, err)
+			assert.ErrorIs(t, err, tt.expErr)
 			assert.Equal(t, tt.expGameState, *g)
 		})
 	}
@@ -98,8 +127,11 @@ func TestGame_SetBoard(t *testing.T) {
 
 func TestGame_CheckGameOver(t *testing.T) {
 	type fields struct {
-		Turn  int
-		Board [][]SquareState
+		Turn        int
+		Board       [][]SquareState
+		WinLength   int
+		Variant     Variant
+		NoOverlines bool
 	}
 	tests := []struct {
 		name          string
@@ -205,12 +237,175 @@ func TestGame_CheckGameOver(t *testing.T) {
 			name:   "Zero",
 			want:   ResultNone,
 		},
+		{
+			name:   "Three in a row on 4x4",
+			fields: fields{
+				WinLength: 3,
+				Board: [][]SquareState{
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateCross},
+					{SquareStateNaught, SquareStateEmpty, SquareStateCross, SquareStateNaught},
+					{SquareStateEmpty, SquareStateCross, SquareStateEmpty, SquareStateNaught},
+				},
+			},
+			want: ResultNInARow,
+			expWinningRow: [][]SquareState{
+				{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateCross},
+				{SquareStateEmpty, SquareStateEmpty, SquareStateCross, SquareStateEmpty},
+				{SquareStateEmpty, SquareStateCross, SquareStateEmpty, SquareStateEmpty},
+			},
+		},
+		{
+			name:   "Three in a row on 4x4 needing four",
+			fields: fields{
+				Board: [][]SquareState{
+					{SquareStateCross, SquareStateCross, SquareStateCross, SquareStateEmpty},
+					{SquareStateNaught, SquareStateNaught, SquareStateNaught, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				},
+			},
+			want: ResultNone,
+		},
+		{
+			name:   "Four in a row on 7x7",
+			fields: fields{
+				WinLength: 4,
+				Board: func() [][]SquareState {
+					board := makeBoard(7)
+					for i := 0; i < 4; i++ {
+						board[2][3+i] = SquareStateNaught
+						board[i][i] = SquareStateCross
+					}
+					board[3][3] = SquareStateNaught
+					return board
+				}(),
+			},
+			want: ResultNInARow,
+			expWinningRow: func() [][]SquareState {
+				board := makeBoard(7)
+				for i := 0; i < 4; i++ {
+					board[2][3+i] = SquareStateNaught
+				}
+				return board
+			}(),
+		},
+		{
+			name:   "Stalemate on 4x4",
+			fields: fields{
+				Turn:      17,
+				Board:     makeBoard(4),
+				WinLength: 3,
+			},
+			want:   ResultStalemate,
+		},
+		{
+			name:   "Overline",
+			fields: fields{
+				WinLength: 5,
+				Board: func() [][]SquareState {
+					board := makeBoard(7)
+					for i := 0; i < 6; i++ {
+						board[3][i] = SquareStateCross
+					}
+					return board
+				}(),
+			},
+			want: ResultNInARow,
+			expWinningRow: func() [][]SquareState {
+				board := makeBoard(7)
+				for i := 0; i < 5; i++ {
+					board[3][i] = SquareStateCross
+				}
+				return board
+			}(),
+		},
+		{
+			name:   "Overline rejected",
+			fields: fields{
+				WinLength:   5,
+				NoOverlines: true,
+				Board: func() [][]SquareState {
+					board := makeBoard(7)
+					for i := 0; i < 6; i++ {
+						board[3][i] = SquareStateCross
+					}
+					return board
+				}(),
+			},
+			want: ResultNone,
+		},
+		{
+			name:   "Exactly five with overlines rejected",
+			fields: fields{
+				WinLength:   5,
+				NoOverlines: true,
+				Board: func() [][]SquareState {
+					board := makeBoard(7)
+					for i := 1; i < 6; i++ {
+						board[i][i] = SquareStateNaught
+					}
+					board[0][0] = SquareStateCross
+					return board
+				}(),
+			},
+			want: ResultNInARow,
+			expWinningRow: func() [][]SquareState {
+				board := makeBoard(7)
+				for i := 1; i < 6; i++ {
+					board[i][i] = SquareStateNaught
+				}
+				return board
+			}(),
+		},
+		{
+			name:   "Misère row",
+			fields: fields{
+				Variant: VariantMisere,
+				Board: [][]SquareState{
+					{SquareStateNaught,SquareStateEmpty,SquareStateEmpty},
+					{SquareStateCross,SquareStateCross,SquareStateCross},
+					{SquareStateEmpty,SquareStateNaught,SquareStateEmpty},
+				},
+			},
+			want: ResultMisereLoss,
+			expWinningRow: [][]SquareState{
+				{SquareStateEmpty,SquareStateEmpty,SquareStateEmpty},
+				{SquareStateCross,SquareStateCross,SquareStateCross},
+				{SquareStateEmpty,SquareStateEmpty,SquareStateEmpty},
+			},
+		},
+		{
+			name:   "Misère stalemate",
+			fields: fields{
+				Variant: VariantMisere,
+				Turn:    10,
+				Board:   makeBoard(3),
+			},
+			want:   ResultStalemate,
+		},
+		{
+			name:   "Misère result none",
+			fields: fields{
+				Variant: VariantMisere,
+				Board: [][]SquareState{
+					{SquareStateCross,SquareStateEmpty,SquareStateEmpty},
+					{SquareStateEmpty,SquareStateNaught,SquareStateEmpty},
+					{SquareStateEmpty,SquareStateEmpty,SquareStateEmpty},
+				},
+			},
+			want:   ResultNone,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := &TicTacToeState{
-				Turn:  tt.fields.Turn,
-				Board: tt.fields.Board,
+				Turn:        tt.fields.Turn,
+				Board:       tt.fields.Board,
+				WinLength:   tt.fields.WinLength,
+				Variant:     tt.fields.Variant,
+				NoOverlines: tt.fields.NoOverlines,
 			}
 			got, gotWinningRow := g.getGameResult()
 			assert.Equal(t, tt.want, got)
@@ -219,7 +414,38 @@ func TestGame_CheckGameOver(t *testing.T) {
 	}
 }
 
-func TestComputeMove(t *testing.T) {
+func TestGame_MoveResult(t *testing.T) {
+	tests := []TicTacToeState{
+		{Board: makeBoard(3)},
+		{Board: makeBoard(7), WinLength: 4},
+		{Board: makeBoard(7), WinLength: 3, Variant: VariantMisere},
+		{Board: makeBoard(15), WinLength: 5, Variant: VariantGomoku},
+		{Board: makeBoard(15), WinLength: 5, Variant: VariantGomoku, NoOverlines: true},
+	}
+	rng := rand.New(rand.NewSource(1))
+	for _, initial := range tests {
+		for game := 0; game < 50; game++ {
+			gs := initial.clone()
+			gs.Board = makeBoard(len(initial.Board))
+			gs.Turn = 1
+			for {
+				moves := gs.emptySquares()
+				move := moves[rng.Intn(len(moves))]
+				_ = gs.occupyPosition(move[0], move[1])
+
+				result, winningRow := gs.getGameResult()
+				gotResult, gotWinningRow := gs.moveResult(move[0], move[1])
+				assert.Equal(t, result, gotResult)
+				assert.Equal(t, winningRow, gotWinningRow)
+				if result != ResultNone {
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestMinimaxEngine_ComputeMove(t *testing.T) {
 	type args struct {
 		gameState TicTacToeState
 		player    rune
@@ -268,13 +494,370 @@ func TestComputeMove(t *testing.T) {
 			wantX: 2,
 			wantY: 1,
 		},
+		{
+			name:  "Crosses Win on 4x4",
+			args:  args{
+				gameState: TicTacToeState{
+					Turn:      5,
+					WinLength: 3,
+					Board: [][]SquareState{
+						{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+						{SquareStateEmpty, SquareStateCross, SquareStateNaught, SquareStateEmpty},
+						{SquareStateEmpty, SquareStateEmpty, SquareStateCross, SquareStateEmpty},
+						{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateNaught},
+					},
+				},
+				player:    'X',
+			},
+			want:  scoreWin,
+			wantX: 0,
+			wantY: 0,
+		},
+		{
+			name:  "Naughts Defend on 4x4",
+			args:  args{
+				gameState: TicTacToeState{
+					Turn:      4,
+					WinLength: 3,
+					Board: [][]SquareState{
+						{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+						{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+						{SquareStateEmpty, SquareStateCross, SquareStateCross, SquareStateNaught},
+						{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+					},
+				},
+				player:    '0',
+			},
+			wantX: 0,
+			wantY: 2,
+		},
+		{
+			name:  "Crosses Avoid Their Line in Misère",
+			args:  args{
+				gameState: TicTacToeState{
+					Turn:    7,
+					Variant: VariantMisere,
+					Board: [][]SquareState{
+						{SquareStateCross, SquareStateCross, SquareStateEmpty},
+						{SquareStateNaught, SquareStateNaught, SquareStateEmpty},
+						{SquareStateNaught, SquareStateCross, SquareStateEmpty},
+					},
+				},
+				player:    'X',
+			},
+			want:  scoreWin - 1,
+			wantX: 2,
+			wantY: 2,
+		},
+		{
+			name:  "Misère Opening",
+			args:  args{
+				gameState: TicTacToeState{
+					Turn:    1,
+					Variant: VariantMisere,
+					Board:   makeBoard(3),
+				},
+				player:    'X',
+			},
+			want:  0,
+			wantX: 1,
+			wantY: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &minimaxEngine{}
+			move, eval, err := e.ComputeMove(context.Background(), tt.args.gameState)
+			assert.NoError(t, err)
+			t.Log(eval, move.X, move.Y)
+			assert.Equal(t, tt.wantX, move.X)
+			assert.Equal(t, tt.wantY, move.Y)
+		})
+	}
+}
+
+// solvePosition is a plain, unpruned minimax used as a reference for
+// the search. It returns the score of the position for the player whose turn
+// it is, with wins and losses discounted by the number of plies to reach them.
+func solvePosition(gameState TicTacToeState, memo map[string]int) int {
+	key := fmt.Sprint(gameState.Board)
+	if score, ok := memo[key]; ok {
+		return score
+	}
+
+	best := math.MinInt32
+	for _, child := range childPositions(gameState) {
+		score := childScore(child, memo)
+		if score > best {
+			best = score
+		}
+	}
+	memo[key] = best
+
+	return best
+}
+
+// childScore scores a position reached by a move from the point of view of
+// the player who made the move.
+func childScore(child TicTacToeState, memo map[string]int) int {
+	result, _ := child.getGameResult()
+	switch result {
+	case ResultNInARow:
+		return scoreWin
+	case ResultStalemate:
+		return 0
+	}
+
+	score := -solvePosition(child, memo)
+	if score > 0 {
+		score--
+	} else if score < 0 {
+		score++
+	}
+
+	return score
+}
+
+func childPositions(gameState TicTacToeState) map[[2]int]TicTacToeState {
+	children := make(map[[2]int]TicTacToeState)
+	for y := range gameState.Board {
+		for x := range gameState.Board[y] {
+			if gameState.isOccupied(x, y) {
+				continue
+			}
+			child := gameState.clone()
+			_ = child.occupyPosition(x, y)
+			children[[2]int{x, y}] = child
+		}
+	}
+
+	return children
+}
+
+func reachablePositions(gameState TicTacToeState, seen map[string]TicTacToeState) {
+	key := fmt.Sprint(gameState.Board)
+	if _, ok := seen[key]; ok {
+		return
+	}
+	if result, _ := gameState.getGameResult(); result != ResultNone {
+		return
+	}
+	seen[key] = gameState
+	for _, child := range childPositions(gameState) {
+		reachablePositions(child, seen)
+	}
+}
+
+func TestMinimaxEngine_AllPositions(t *testing.T) {
+	positions := make(map[string]TicTacToeState)
+	reachablePositions(TicTacToeState{Board: makeBoard(3), Turn: 1}, positions)
+	assert.Equal(t, 4520, len(positions))
+
+	memo := make(map[string]int)
+	e := &minimaxEngine{}
+	for key, gameState := range positions {
+		want := solvePosition(gameState, memo)
+		move, eval, err := e.ComputeMove(context.Background(), gameState)
+		assert.NoError(t, err, key)
+		assert.Equal(t, Evaluation(want)/scoreWin, eval, key)
+
+		child := childPositions(gameState)[[2]int{move.X, move.Y}]
+		if assert.NotNil(t, child.Board, key) {
+			assert.Equal(t, want, childScore(child, memo), key)
+		}
+	}
+}
+
+func TestTicTacToeStateHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+		expSize       int
+		expWinLength  int
+		expResult     Result
+		expLoser      rune
+	}{
+		{
+			name:          "Default board",
+			body:          `{}`,
+			expStatusCode: http.StatusOK,
+			expSize:       3,
+			expWinLength:  3,
+		},
+		{
+			name:          "Empty 4x4 with three in a row",
+			body:          `{"size": 4, "winLength": 3}`,
+			expStatusCode: http.StatusOK,
+			expSize:       4,
+			expWinLength:  3,
+		},
+		{
+			name:          "Board size taken from the board",
+			body:          `{"board": [[88,88,0,0],[48,48,0,0],[0,0,0,0],[0,0,0,0]], "winLength": 3}`,
+			expStatusCode: http.StatusOK,
+			expSize:       4,
+			expWinLength:  3,
+			expResult:     ResultNInARow,
+		},
+		{
+			name:          "Win length longer than the board",
+			body:          `{"size": 3, "winLength": 4}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Size disagrees with the board",
+			body:          `{"size": 4, "board": [[0,0,0],[0,0,0],[0,0,0]]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Beginner",
+			body:          `{"difficulty": "beginner"}`,
+			expStatusCode: http.StatusOK,
+			expSize:       3,
+			expWinLength:  3,
+		},
+		{
+			name:          "Intermediate without mistakes",
+			body:          `{"difficulty": "intermediate", "mistakeChance": 0}`,
+			expStatusCode: http.StatusOK,
+			expSize:       3,
+			expWinLength:  3,
+		},
+		{
+			name:          "Unknown difficulty",
+			body:          `{"difficulty": "impossible"}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Monte Carlo tree search",
+			body:          `{"size": 4, "winLength": 3, "engine": "mcts", "iterations": 500, "seed": 1}`,
+			expStatusCode: http.StatusOK,
+			expSize:       4,
+			expWinLength:  3,
+		},
+		{
+			name:          "Unknown engine",
+			body:          `{"engine": "alphazero"}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Computer opens as crosses",
+			body:          `{"humanPlayer": 48}`,
+			expStatusCode: http.StatusOK,
+			expSize:       3,
+			expWinLength:  3,
+		},
+		{
+			name:          "Human to move",
+			body:          `{"humanPlayer": 88}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Naughts move first",
+			body:          `{"firstPlayer": 48, "humanPlayer": 88}`,
+			expStatusCode: http.StatusOK,
+			expSize:       3,
+			expWinLength:  3,
+		},
+		{
+			name:          "Unknown player",
+			body:          `{"firstPlayer": 65}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Second player moved first",
+			body:          `{"firstPlayer": 48, "board": [[88,0,0],[0,0,0],[0,0,0]]}`,
+			expStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "Misère",
+			body:          `{"variant": "misere"}`,
+			expStatusCode: http.StatusOK,
+			expSize:       3,
+			expWinLength:  3,
+		},
+		{
+			name:          "Misère forced to complete a line",
+			body:          `{"variant": "misere", "board": [[88,88,0],[48,48,88],[88,48,48]]}`,
+			expStatusCode: http.StatusOK,
+			expSize:       3,
+			expWinLength:  3,
+			expResult:     ResultMisereLoss,
+			expLoser:      'X',
+		},
+		{
+			name:          "Unknown variant",
+			body:          `{"variant": "reverse"}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Gomoku",
+			body:          `{"variant": "gomoku", "timeBudget": 100}`,
+			expStatusCode: http.StatusOK,
+			expSize:       15,
+			expWinLength:  5,
+		},
+		{
+			name:          "Gomoku without overlines",
+			body:          `{"variant": "gomoku", "noOverlines": true, "size": 19, "timeBudget": 100}`,
+			expStatusCode: http.StatusOK,
+			expSize:       19,
+			expWinLength:  5,
+		},
+		{
+			name:          "Board too large",
+			body:          `{"size": 20}`,
+			expStatusCode: http.StatusBadRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, gotX, gotY := computeMove(tt.args.gameState, true)
-			t.Log(got, gotX, gotY)
-			assert.Equal(t, tt.wantX, gotX)
-			assert.Equal(t, tt.wantY, gotY)
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			TicTacToeStateHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+			if tt.expStatusCode != http.StatusOK {
+				return
+			}
+
+			resp := TicTacToeStateResponse{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Len(t, resp.Board, tt.expSize)
+			assert.Equal(t, tt.expWinLength, resp.WinLength)
+			assert.Equal(t, tt.expResult, resp.Result)
+			assert.Equal(t, tt.expLoser, resp.Loser)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestTicTacToeStateHandler_GomokuDefaultBudget(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "Empty board",
+			body: `{"variant": "gomoku"}`,
+		},
+		{
+			name: "Opening",
+			body: `{"variant": "gomoku", "board": [` + strings.Repeat(`[0,0,0,0,0,0,0,0,0,0,0,0,0,0,0],`, 7) +
+				`[0,0,0,0,0,0,0,88,48,0,0,0,0,0,0],` + strings.Repeat(`[0,0,0,0,0,0,0,0,0,0,0,0,0,0,0],`, 6) +
+				`[0,0,0,0,0,0,0,0,0,0,0,0,0,0,0]]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			start := time.Now()
+			TicTacToeStateHandler(w, r, nil)
+			elapsed := time.Since(start)
+			t.Logf("answered in %v", elapsed)
+			assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			assert.Less(t, int64(elapsed), int64(time.Second))
+		})
+	}
+}
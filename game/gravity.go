@@ -0,0 +1,73 @@
+package game
+
+// dropRow returns the row a piece played in column x of a gravity board
+// comes to rest on, the lowest empty square of the column, or -1 when the
+// column is full.
+func (t *TicTacToeState) dropRow(x int) int {
+	for y := len(t.Board) - 1; y >= 0; y-- {
+		if t.Board[y][x] == SquareStateEmpty {
+			return y
+		}
+	}
+
+	return -1
+}
+
+// dropPiece plays the player whose turn it is in column x of a gravity
+// board, returning the row the piece comes to rest on.
+func (t *TicTacToeState) dropPiece(x int) (int, error) {
+	if len(t.Board) == 0 || x < 0 || x >= len(t.Board[0]) {
+		return 0, ErrInvalidColumn
+	}
+	y := t.dropRow(x)
+	if y < 0 {
+		return 0, ErrColumnFull
+	}
+
+	return y, t.occupyPosition(x, y)
+}
+
+// gravityMoves returns the square each column that is not full would take
+// the next piece played in it to, from left to right.
+func (t *TicTacToeState) gravityMoves() [][2]int {
+	if len(t.Board) == 0 {
+		return nil
+	}
+
+	var squares [][2]int
+	for x := range t.Board[0] {
+		if y := t.dropRow(x); y >= 0 {
+			squares = append(squares, [2]int{x, y})
+		}
+	}
+
+	return squares
+}
+
+// emptyCount returns the number of empty squares on the board.
+func (t *TicTacToeState) emptyCount() int {
+	n := 0
+	for _, row := range t.Board {
+		for _, square := range row {
+			if square == SquareStateEmpty {
+				n++
+			}
+		}
+	}
+
+	return n
+}
+
+// isSettled reports whether every piece on a gravity board rests on the
+// bottom row or on another piece.
+func (t *TicTacToeState) isSettled() bool {
+	for y := 0; y < len(t.Board)-1; y++ {
+		for x, square := range t.Board[y] {
+			if square != SquareStateEmpty && t.Board[y+1][x] == SquareStateEmpty {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,252 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// makeGravityState returns an empty board seven columns wide and six rows
+// high with crosses and naughts dropped in turn into the given columns.
+func makeGravityState(columns ...int) TicTacToeState {
+	g := TicTacToeState{
+		Board:     makeRectangle(gravityWidth, gravityHeight),
+		WinLength: gravityWinLength,
+		Variant:   VariantGravity,
+		Turn:      1,
+	}
+	for _, x := range columns {
+		if _, err := g.dropPiece(x); err != nil {
+			panic(err)
+		}
+	}
+
+	return g
+}
+
+func TestGravity_LegalMoves(t *testing.T) {
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		expMoves  [][2]int
+	}{
+		{
+			name:      "Empty",
+			gameState: makeGravityState(),
+			expMoves:  [][2]int{{0, 5}, {1, 5}, {2, 5}, {3, 5}, {4, 5}, {5, 5}, {6, 5}},
+		},
+		{
+			name:      "Stacked",
+			gameState: makeGravityState(3, 3, 3, 0),
+			expMoves:  [][2]int{{0, 4}, {1, 5}, {2, 5}, {3, 2}, {4, 5}, {5, 5}, {6, 5}},
+		},
+		{
+			name:      "Full column",
+			gameState: makeGravityState(0, 0, 0, 0, 0, 0),
+			expMoves:  [][2]int{{1, 5}, {2, 5}, {3, 5}, {4, 5}, {5, 5}, {6, 5}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expMoves, tt.gameState.legalMoves())
+		})
+	}
+}
+
+func TestGravity_OccupyPosition(t *testing.T) {
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		x, y      int
+		expErr    error
+	}{
+		{
+			name:      "Bottom row",
+			gameState: makeGravityState(),
+			x:         3,
+			y:         5,
+		},
+		{
+			name:      "On another piece",
+			gameState: makeGravityState(3),
+			x:         3,
+			y:         4,
+		},
+		{
+			name:      "Floating",
+			gameState: makeGravityState(),
+			x:         3,
+			y:         4,
+			expErr:    ErrIllegalMove,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.gameState.occupyPosition(tt.x, tt.y)
+			assert.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
+
+func TestGravity_DropPiece(t *testing.T) {
+	g := makeGravityState(0, 0, 0, 0, 0)
+	y, err := g.dropPiece(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, y)
+
+	_, err = g.dropPiece(0)
+	assert.ErrorIs(t, err, ErrColumnFull)
+	_, err = g.dropPiece(gravityWidth)
+	assert.ErrorIs(t, err, ErrInvalidColumn)
+}
+
+func TestGravity_GetGameResult(t *testing.T) {
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		want      Result
+	}{
+		{
+			name:      "Three in a column",
+			gameState: makeGravityState(2, 3, 2, 3, 2),
+			want:      ResultNone,
+		},
+		{
+			name:      "Four in a column",
+			gameState: makeGravityState(2, 3, 2, 3, 2, 3, 2),
+			want:      ResultNInARow,
+		},
+		{
+			name:      "Four along the bottom",
+			gameState: makeGravityState(3, 3, 4, 4, 5, 5, 6),
+			want:      ResultNInARow,
+		},
+		{
+			name:      "Four on a diagonal",
+			gameState: makeGravityState(0, 1, 1, 2, 2, 3, 2, 3, 3, 6, 3),
+			want:      ResultNInARow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := tt.gameState.getGameResult()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGravity_ComputeMove(t *testing.T) {
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		expMove   Move
+	}{
+		{
+			name:      "Crosses Win",
+			gameState: makeGravityState(2, 5, 3, 5, 4, 0),
+			expMove:   Move{X: 1, Y: 5},
+		},
+		{
+			name:      "Naughts Block",
+			gameState: makeGravityState(6, 3, 6, 3, 6),
+			expMove:   Move{X: 6, Y: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &minimaxEngine{budget: 200 * time.Millisecond}
+			move, _, err := e.ComputeMove(context.Background(), tt.gameState)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expMove, move)
+		})
+	}
+}
+
+func TestGravityStateHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+		expWidth      int
+		expHeight     int
+		expTurn       int
+	}{
+		{
+			name:          "Default board",
+			body:          `{"variant": "gravity", "timeBudget": 50}`,
+			expStatusCode: http.StatusOK,
+			expWidth:      7,
+			expHeight:     6,
+			expTurn:       2,
+		},
+		{
+			name:          "Column move",
+			body:          `{"variant": "gravity", "timeBudget": 50, "humanPlayer": 88, "column": 3}`,
+			expStatusCode: http.StatusOK,
+			expWidth:      7,
+			expHeight:     6,
+			expTurn:       3,
+		},
+		{
+			name:          "Rectangle",
+			body:          `{"variant": "gravity", "timeBudget": 50, "width": 5, "height": 4}`,
+			expStatusCode: http.StatusOK,
+			expWidth:      5,
+			expHeight:     4,
+			expTurn:       2,
+		},
+		{
+			name: "Full column",
+			body: `{"variant": "gravity", "column": 0, "winLength": 3, ` +
+				`"board": [[88,0,0],[48,0,0],[88,0,0],[48,0,0]]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Floating piece",
+			body:          `{"variant": "gravity", "board": [[88,0,0],[0,0,0],[0,0,0]], "winLength": 3}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Jagged board",
+			body:          `{"variant": "gravity", "board": [[0,0,0],[0,0]], "winLength": 2}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Column move outside the gravity variant",
+			body:          `{"column": 1}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Column move out of turn",
+			body:          `{"variant": "gravity", "humanPlayer": 48, "column": 1}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			TicTacToeStateHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+			if tt.expStatusCode != http.StatusOK {
+				return
+			}
+
+			resp := TicTacToeStateResponse{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			if assert.Len(t, resp.Board, tt.expHeight) {
+				assert.Len(t, resp.Board[0], tt.expWidth)
+			}
+			assert.Equal(t, tt.expTurn, resp.Turn)
+			g := TicTacToeState{Board: resp.Board}
+			assert.True(t, g.isSettled())
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package game
+
+// lineWeights scores a line of winLength squares that only one player has
+// pieces in by the number of pieces in it, up to the last weight.
+var lineWeights = []int{0, 1, 8, 64, 512, 4096, 32768}
+
+// maxHeuristic bounds heuristic scores so that they are never mistaken for
+// wins.
+const maxHeuristic = scoreWin/2 - 1
+
+// Scores of threats: lines missing a single piece.
+const (
+	// scoreThreat is the score of the player to move having a threat,
+	// which they will complete on their move.
+	scoreThreat = scoreWin / 4
+	// scoreDoubleThreat is the score of the opponent having threats on two
+	// squares, which the player to move cannot both block.
+	scoreDoubleThreat = scoreWin / 8
+)
+
+// heuristic estimates the value of a position to player, whose turn it is,
+// for use where the search is cut off before the end of the game. Every line
+// of winLength squares that only one player has pieces in is still open to
+// that player and counts towards them, more so the more pieces it holds. In
+// the misère variant open lines count against the player instead, and threats
+// are left unscored since a player simply avoids completing their own line.
+// In the wild variant only a line the player can complete counts, and in
+// Order and Chaos every open line counts towards Order whatever its symbol.
+// On a toroidal board the lines counted include those across the edges, and
+// lines through a blocked square are never open to anyone.
+func (t *TicTacToeState) heuristic(player SquareState) int {
+	k := t.winLength()
+	score, open := 0, 0
+	var threats, opponentThreats [][2]int
+
+	for _, d := range lineDirections {
+		for y := range t.Board {
+			for x := range t.Board[y] {
+				ex, ey := t.wrap(x+(k-1)*d[0], y+(k-1)*d[1])
+				if ey >= len(t.Board) || ex < 0 || ex >= len(t.Board[ey]) {
+					continue
+				}
+
+				mine, theirs, blocked := 0, 0, false
+				emptyX, emptyY := 0, 0
+				for i := 0; i < k; i++ {
+					px, py := t.wrap(x+i*d[0], y+i*d[1])
+					switch t.Board[py][px] {
+					case SquareStateEmpty:
+						emptyX, emptyY = px, py
+					case SquareStateBlocked:
+						blocked = true
+					case player:
+						mine++
+					default:
+						theirs++
+					}
+				}
+
+				switch {
+				case blocked, mine > 0 && theirs > 0:
+				case mine > 0:
+					score += lineWeights[minInt(mine, len(lineWeights)-1)]
+					open += lineWeights[minInt(mine, len(lineWeights)-1)]
+					if mine == k-1 {
+						threats = appendSquare(threats, emptyX, emptyY)
+					}
+				case theirs > 0:
+					score -= lineWeights[minInt(theirs, len(lineWeights)-1)]
+					open += lineWeights[minInt(theirs, len(lineWeights)-1)]
+					if theirs == k-1 {
+						opponentThreats = appendSquare(opponentThreats, emptyX, emptyY)
+					}
+				}
+			}
+		}
+	}
+
+	if t.variant() == VariantOrderChaos {
+		// Order completes a line of either symbol on their move, and Chaos
+		// cannot block lines missing pieces on two different squares.
+		squares := threats
+		for _, square := range opponentThreats {
+			squares = appendSquare(squares, square[0], square[1])
+		}
+		if t.role(rune(player)) == RoleOrder {
+			score = open
+			if len(squares) > 0 {
+				score += scoreThreat
+			}
+		} else {
+			score = -open
+			if len(squares) > 1 {
+				score -= scoreDoubleThreat
+			}
+		}
+	} else if t.variant() == VariantWild {
+		// Lines belong to neither player, so all that counts is whether the
+		// player to move can complete one, of either symbol.
+		score = 0
+		if len(threats) > 0 || len(opponentThreats) > 0 {
+			score = scoreThreat
+		}
+	} else if t.variant() == VariantMisere {
+		score = -score
+	} else if len(threats) > 0 {
+		score += scoreThreat
+	} else if len(opponentThreats) > 1 {
+		score -= scoreDoubleThreat
+	}
+	if score > maxHeuristic {
+		return maxHeuristic
+	} else if score < -maxHeuristic {
+		return -maxHeuristic
+	}
+
+	return score
+}
+
+// appendSquare appends x, y to squares unless it is already there.
+func appendSquare(squares [][2]int, x, y int) [][2]int {
+	for _, square := range squares {
+		if square[0] == x && square[1] == y {
+			return squares
+		}
+	}
+
+	return append(squares, [2]int{x, y})
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
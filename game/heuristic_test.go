@@ -0,0 +1,79 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGame_Heuristic(t *testing.T) {
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		player    SquareState
+		want      func(score int) bool
+	}{
+		{
+			name:      "Empty board",
+			gameState: TicTacToeState{Board: makeBoard(4), WinLength: 3},
+			player:    SquareStateCross,
+			want:      func(score int) bool { return score == 0 },
+		},
+		{
+			name: "Centre against a corner",
+			gameState: TicTacToeState{
+				Board: [][]SquareState{
+					{SquareStateNaught, SquareStateEmpty, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateCross, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				},
+			},
+			player: SquareStateCross,
+			want:   func(score int) bool { return score > 0 && score < scoreDoubleThreat },
+		},
+		{
+			name: "Threat for the player to move",
+			gameState: TicTacToeState{
+				Board: [][]SquareState{
+					{SquareStateCross, SquareStateCross, SquareStateEmpty},
+					{SquareStateNaught, SquareStateNaught, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				},
+			},
+			player: SquareStateNaught,
+			want:   func(score int) bool { return score >= scoreThreat-scoreWin/100 },
+		},
+		{
+			name: "Double threat for the opponent",
+			gameState: TicTacToeState{
+				Board: [][]SquareState{
+					{SquareStateCross, SquareStateCross, SquareStateEmpty},
+					{SquareStateNaught, SquareStateCross, SquareStateEmpty},
+					{SquareStateNaught, SquareStateEmpty, SquareStateEmpty},
+				},
+			},
+			player: SquareStateNaught,
+			want:   func(score int) bool { return score <= -scoreDoubleThreat },
+		},
+		{
+			name: "Bounded on large boards",
+			gameState: func() TicTacToeState {
+				board := makeBoard(19)
+				for y := 0; y < 19; y += 2 {
+					for x := range board[y] {
+						board[y][x] = SquareStateCross
+					}
+				}
+				return TicTacToeState{Board: board, WinLength: 19}
+			}(),
+			player: SquareStateCross,
+			want:   func(score int) bool { return score == maxHeuristic && !isWinScore(score) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.gameState.heuristic(tt.player)
+			assert.True(t, tt.want(got), "heuristic returned %d", got)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package game
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+)
+
+// infiniteSize is the length of each side of the infinite variant's board.
+const infiniteSize = 3
+
+// infinitePieces is the most pieces each player keeps on the board.
+const infinitePieces = 3
+
+// infiniteRepetitions is the number of times the same position has to come up
+// for the game to be drawn.
+const infiniteRepetitions = 3
+
+// InfiniteState is a game of infinite tic-tac-toe, in which each player keeps
+// at most three pieces on a 3x3 board: placing a fourth removes the oldest of
+// them. The board never fills up, so a game that nobody wins is drawn once the
+// same position comes up for the third time. The request gives the game as
+// the Moves made since it began, since the board alone does not say which
+// pieces are oldest.
+type InfiniteState struct {
+	Moves   []Move  `json:"moves"`
+	Variant Variant `json:"variant"`
+	// FirstPlayer and HumanPlayer work as they do in a TicTacToeState.
+	FirstPlayer SquareState `json:"firstPlayer,omitempty"`
+	HumanPlayer SquareState `json:"humanPlayer,omitempty"`
+
+	Board [][]SquareState `json:"-"`
+	Turn  int             `json:"-"`
+	// pieces holds the squares of the first and second player's pieces,
+	// each as x + 3y, oldest first.
+	pieces [2][]int
+	// seen counts the times each position has come up, keyed by
+	// infiniteKey.
+	seen map[int]int
+}
+
+// InfiniteStateResponse describes the game after the computer's move. Fading
+// is the piece the player to move loses when they next place one, once they
+// have as many as they may keep.
+type InfiniteStateResponse struct {
+	Board      [][]SquareState `json:"board"`
+	Moves      []Move          `json:"moves"`
+	Result     Result          `json:"result,omitempty"`
+	WinningRow [][]SquareState `json:"winningRow,omitempty"`
+	Winner     rune            `json:"winner,omitempty"`
+	Turn       int             `json:"turn"`
+	NextPlayer rune            `json:"nextPlayer"`
+	Fading     *Move           `json:"fading,omitempty"`
+}
+
+// infiniteStateHandler serves game state requests for the infinite variant,
+// whose body b holds an InfiniteState, responding with an
+// InfiniteStateResponse after the computer's move.
+func infiniteStateHandler(w http.ResponseWriter, r *http.Request, b []byte) {
+	req := &InfiniteState{}
+	err := json.Unmarshal(b, req)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "could not interpret request", err)
+		return
+	}
+
+	err = req.initialize()
+	if err != nil {
+		writeHTTPError(w, errorStatus(err), "invalid game settings", err)
+		return
+	}
+
+	result, _ := req.getGameResult()
+	if result == ResultNone && SquareState(req.playersTurn()) == req.HumanPlayer {
+		writeHTTPError(w, errorStatus(ErrNotComputersTurn), "invalid game state", ErrNotComputersTurn)
+		return
+	}
+	if result == ResultNone {
+		engine := &infiniteEngine{}
+		move, _, err := engine.ComputeMove(r.Context(), *req)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to compute move", err)
+			return
+		}
+		err = req.occupyPosition(move.X, move.Y)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to set board", err)
+			return
+		}
+	}
+
+	result, winningRow := req.getGameResult()
+	resp := InfiniteStateResponse{
+		Board:      req.Board,
+		Moves:      req.Moves,
+		Result:     result,
+		WinningRow: winningRow,
+		Turn:       req.Turn,
+		NextPlayer: req.playersTurn(),
+	}
+	if result == ResultNInARow {
+		resp.Winner = linePlayer(winningRow)
+	}
+	if mine := req.pieces[req.moverIndex()]; result == ResultNone && len(mine) == infinitePieces {
+		resp.Fading = &Move{X: mine[0] % infiniteSize, Y: mine[0] / infiniteSize}
+	}
+
+	b, err = json.Marshal(resp)
+	if err != nil {
+		writeHTTPError(w, http.StatusInternalServerError, "failed to marshal response", err)
+		return
+	}
+
+	_, err = w.Write(b)
+	if err != nil {
+		log.Print(err)
+	}
+}
+
+// initialize prepares an infinite state received in a request by replaying
+// its moves on an empty board, checking that each of them was legal and that
+// none was made after the game ended.
+func (n *InfiniteState) initialize() error {
+	if !isPlayer(n.FirstPlayer) && n.FirstPlayer != SquareStateEmpty ||
+		!isPlayer(n.HumanPlayer) && n.HumanPlayer != SquareStateEmpty {
+		return ErrInvalidPlayer
+	}
+
+	moves := n.Moves
+	n.Moves = nil
+	n.Board = makeBoard(infiniteSize)
+	n.Turn = 1
+	n.pieces = [2][]int{}
+	n.seen = map[int]int{n.key(): 1}
+	for _, move := range moves {
+		if result, _ := n.getGameResult(); result != ResultNone {
+			return ErrGameOver
+		}
+		err := n.occupyPosition(move.X, move.Y)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (n *InfiniteState) playersTurn() rune {
+	first := opener(n.FirstPlayer)
+	if n.Turn%2 == 1 {
+		return rune(first)
+	}
+
+	return rune(opponent(first))
+}
+
+// moverIndex returns the index into pieces of the player whose turn it is.
+func (n *InfiniteState) moverIndex() int {
+	return 1 - n.Turn%2
+}
+
+// occupyPosition places a piece on square x, y for the player whose turn it
+// is, removing their oldest piece when they already have as many as they may
+// keep.
+func (n *InfiniteState) occupyPosition(x, y int) error {
+	if x < 0 || x >= infiniteSize || y < 0 || y >= infiniteSize {
+		return ErrOutOfBounds
+	}
+	if n.Board[y][x] != SquareStateEmpty {
+		return ErrOccupied
+	}
+
+	mine := n.pieces[n.moverIndex()]
+	if len(mine) == infinitePieces {
+		oldest := mine[0]
+		n.Board[oldest/infiniteSize][oldest%infiniteSize] = SquareStateEmpty
+		mine = mine[1:]
+	}
+	n.pieces[n.moverIndex()] = append(append([]int(nil), mine...), x+y*infiniteSize)
+	n.Board[y][x] = SquareState(n.playersTurn())
+	n.Moves = append(n.Moves, Move{X: x, Y: y})
+	n.Turn++
+	n.seen[n.key()]++
+
+	return nil
+}
+
+// key identifies the position: the pieces of each player, in the order they
+// were placed, and whose turn it is.
+func (n *InfiniteState) key() int {
+	mover := n.moverIndex()
+	return infiniteKey(n.pieces[mover], n.pieces[1-mover])*2 + mover
+}
+
+// getGameResult calculates the current state of the game returning the result
+// and the row that concluded the game if there is a complete row, nil
+// otherwise. A game that nobody has won is drawn by repetition once the
+// current position has come up three times.
+func (n *InfiniteState) getGameResult() (Result, [][]SquareState) {
+	lines := &TicTacToeState{Board: n.Board, Turn: len(n.pieces[0]) + len(n.pieces[1]) + 1}
+	if result, row := lines.getGameResult(); result != ResultNone {
+		return result, row
+	}
+	if n.seen[n.key()] >= infiniteRepetitions {
+		return ResultRepetition, nil
+	}
+
+	return ResultNone, nil
+}
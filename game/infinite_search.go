@@ -0,0 +1,253 @@
+package game
+
+import (
+	"context"
+	"math"
+	"sync"
+)
+
+// infiniteKeys is the number of keys infiniteKey can return.
+const infiniteKeys = 1000 * 1000
+
+var (
+	infiniteOnce sync.Once
+	// infiniteSolution holds the solution of every position of the infinite
+	// variant, by infiniteKey: a positive number of plies to a win for the
+	// player to move, a negative number of plies to a loss, or zero for a
+	// draw, where the game goes round in circles under perfect play.
+	infiniteSolution []int16
+)
+
+// infiniteKey identifies the position in which the player to move has pieces
+// on the squares mine and their opponent on the squares theirs, each given as
+// x + 3y, oldest first. Each list is written out as decimal digits one more
+// than its squares, mine in the top three digits.
+func infiniteKey(mine, theirs []int) int {
+	key := 0
+	for _, square := range mine {
+		key = key*10 + square + 1
+	}
+	key *= 1000
+	other := 0
+	for _, square := range theirs {
+		other = other*10 + square + 1
+	}
+
+	return key + other
+}
+
+// infinitePlace returns the pieces of a player with pieces mine after they
+// place one on square, along with whether that completes a line.
+func infinitePlace(mine []int, square int) ([]int, bool) {
+	if len(mine) == infinitePieces {
+		mine = mine[1:]
+	}
+	placed := append(append(make([]int, 0, infinitePieces), mine...), square)
+
+	var mask uint16
+	for _, s := range placed {
+		mask |= 1 << uint(s)
+	}
+	for _, line := range notaktoLines {
+		if mask&line == line {
+			return placed, true
+		}
+	}
+
+	return placed, false
+}
+
+// solveInfinite works out infiniteSolution by retrograde analysis of every
+// position in which the players have placed their pieces on distinct squares.
+func solveInfinite() {
+	// Lists of distinct squares, oldest first, by length.
+	lists := [infinitePieces + 1][][]int{{{}}}
+	for n := 1; n <= infinitePieces; n++ {
+		for _, list := range lists[n-1] {
+			for s := 0; s < infiniteSize*infiniteSize; s++ {
+				if !containsSquare(list, s) {
+					lists[n] = append(lists[n], append(append([]int(nil), list...), s))
+				}
+			}
+		}
+	}
+
+	var positions []retrogradePosition
+	for a := 0; a <= infinitePieces; a++ {
+		// The opponent has placed as many pieces as the player to move,
+		// or one more when they moved first.
+		for b := a; b <= a+1 && b <= infinitePieces; b++ {
+			for _, mine := range lists[a] {
+				for _, theirs := range lists[b] {
+					if squaresOverlap(mine, theirs) {
+						continue
+					}
+					p := retrogradePosition{key: infiniteKey(mine, theirs)}
+					for s := 0; s < infiniteSize*infiniteSize; s++ {
+						if containsSquare(mine, s) || containsSquare(theirs, s) {
+							continue
+						}
+						placed, won := infinitePlace(mine, s)
+						if won {
+							p.winNow = true
+							break
+						}
+						p.successors = append(p.successors, infiniteKey(theirs, placed))
+					}
+					positions = append(positions, p)
+				}
+			}
+		}
+	}
+
+	infiniteSolution = solveRetrograde(positions, infiniteKeys)
+}
+
+// retrogradePosition is a position of a game solved by solveRetrograde: its
+// key, the keys of the positions its moves lead to, and whether one of its
+// moves wins the game at once.
+type retrogradePosition struct {
+	key        int
+	successors []int
+	winNow     bool
+}
+
+// solveRetrograde solves a game whose positions are identified by keys below
+// keys, returning the solution of each by its key: a positive number of plies
+// to a win for the player to move, a negative number of plies to a loss, or
+// zero for a draw. Positions with a winning move are wins in one ply,
+// positions all of whose moves lead to wins for the opponent are losses,
+// positions with a move leading to a loss for the opponent are wins, and so
+// on, one ply further each round, until a round settles nothing more.
+// Whatever is left is a draw, where the game goes round in circles under
+// perfect play, as are positions with no moves at all.
+func solveRetrograde(positions []retrogradePosition, keys int) []int16 {
+	solution := make([]int16, keys)
+	for _, p := range positions {
+		if p.winNow {
+			solution[p.key] = 1
+		}
+	}
+	for settled := true; settled; {
+		settled = false
+		next := append([]int16(nil), solution...)
+		for _, p := range positions {
+			if solution[p.key] != 0 || len(p.successors) == 0 {
+				continue
+			}
+			// The quickest win, or failing that the slowest loss.
+			win, loss, lost := int16(0), int16(0), true
+			for _, key := range p.successors {
+				switch v := solution[key]; {
+				case v < 0 && (win == 0 || 1-v < win):
+					win = 1 - v
+				case v > 0 && -1-v < loss:
+					loss = -1 - v
+				case v == 0:
+					lost = false
+				}
+			}
+			if win != 0 {
+				next[p.key] = win
+				settled = true
+			} else if lost {
+				next[p.key] = loss
+				settled = true
+			}
+		}
+		solution = next
+	}
+
+	return solution
+}
+
+// containsSquare reports whether square is one of squares.
+func containsSquare(squares []int, square int) bool {
+	for _, s := range squares {
+		if s == square {
+			return true
+		}
+	}
+
+	return false
+}
+
+// squaresOverlap reports whether a and b have a square in common.
+func squaresOverlap(a, b []int) bool {
+	for _, s := range a {
+		if containsSquare(b, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// infiniteEngine plays perfect infinite tic-tac-toe by the solution of every
+// position, which it works out the first time it is asked for a move. It
+// wins as quickly as it can, loses as slowly as it can, and otherwise keeps
+// the game drawn. A move that brings a position up for the third time counts
+// as a draw whatever the solution says, which a losing engine takes and a
+// winning one avoids.
+type infiniteEngine struct{}
+
+func (e *infiniteEngine) ComputeMove(ctx context.Context, n InfiniteState) (Move, Evaluation, error) {
+	infiniteOnce.Do(solveInfinite)
+
+	mover := n.moverIndex()
+	mine, theirs := n.pieces[mover], n.pieces[1-mover]
+	var best *Move
+	bestValue := 0
+	for s := 0; s < infiniteSize*infiniteSize; s++ {
+		if containsSquare(mine, s) || containsSquare(theirs, s) {
+			continue
+		}
+		// The value of the move for the player making it, ranked as
+		// the solution is.
+		placed, won := infinitePlace(mine, s)
+		value := 1
+		if !won {
+			key := infiniteKey(theirs, placed)
+			switch v := int(infiniteSolution[key]); {
+			case n.seen[key*2+1-mover] >= infiniteRepetitions-1:
+				value = 0
+			case v < 0:
+				value = 1 - v
+			case v > 0:
+				value = -1 - v
+			default:
+				value = 0
+			}
+		}
+		if best == nil || plyRank(value) > plyRank(bestValue) {
+			best = &Move{X: s % infiniteSize, Y: s / infiniteSize}
+			bestValue = value
+		}
+	}
+	if best == nil {
+		return Move{}, 0, ErrNoMoves
+	}
+
+	switch {
+	case bestValue > 0:
+		return *best, 1, nil
+	case bestValue < 0:
+		return *best, -1, nil
+	}
+
+	return *best, 0, nil
+}
+
+// plyRank ranks the outcome v, a number of plies to a win if positive, to a
+// loss if negative, or zero for a draw: the quicker a win the higher, then
+// draws, then losses, the slower the higher.
+func plyRank(v int) int {
+	switch {
+	case v > 0:
+		return math.MaxInt16 - v
+	case v < 0:
+		return -math.MaxInt16 - v
+	}
+
+	return 0
+}
@@ -0,0 +1,243 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// infiniteCycle is a round of eight moves, crosses on squares 0, 1, 3 and 5
+// and naughts on 2, 4, 7 and 8, none three of which make a line. Played over
+// and over it brings the same positions up again and again.
+var infiniteCycle = []Move{
+	{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1},
+	{X: 0, Y: 1}, {X: 1, Y: 2}, {X: 2, Y: 1}, {X: 2, Y: 2},
+}
+
+// repeatCycle returns the moves of infiniteCycle played over and over, n of
+// them in all.
+func repeatCycle(n int) []Move {
+	moves := make([]Move, n)
+	for i := range moves {
+		moves[i] = infiniteCycle[i%len(infiniteCycle)]
+	}
+
+	return moves
+}
+
+func TestInfinite_OccupyPosition(t *testing.T) {
+	n := InfiniteState{Moves: repeatCycle(6)}
+	assert.NoError(t, n.initialize())
+	assert.Equal(t, SquareStateCross, n.Board[0][0])
+
+	// Crosses place a fourth piece, and their first, on 0, 0, disappears.
+	assert.NoError(t, n.occupyPosition(2, 1))
+	assert.Equal(t, SquareStateEmpty, n.Board[0][0])
+	assert.Equal(t, SquareStateCross, n.Board[1][2])
+	assert.Equal(t, []int{1, 3, 5}, n.pieces[0])
+	assert.Len(t, n.Moves, 7)
+
+	assert.ErrorIs(t, n.occupyPosition(1, 1), ErrOccupied)
+	assert.ErrorIs(t, n.occupyPosition(3, 0), ErrOutOfBounds)
+	assert.NoError(t, n.occupyPosition(0, 0))
+}
+
+func TestInfinite_Initialize(t *testing.T) {
+	tests := []struct {
+		name   string
+		moves  []Move
+		expErr error
+	}{
+		{
+			name:  "Long game",
+			moves: repeatCycle(20),
+		},
+		{
+			name:   "Occupied",
+			moves:  []Move{{X: 1, Y: 1}, {X: 1, Y: 1}},
+			expErr: ErrOccupied,
+		},
+		{
+			name:   "Move after a win",
+			moves:  []Move{{X: 0, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 0}, {X: 2, Y: 1}},
+			expErr: ErrGameOver,
+		},
+		{
+			name:   "Move after a draw",
+			moves:  repeatCycle(23),
+			expErr: ErrGameOver,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := InfiniteState{Moves: tt.moves}
+			assert.ErrorIs(t, n.initialize(), tt.expErr)
+		})
+	}
+}
+
+func TestInfinite_GetGameResult(t *testing.T) {
+	tests := []struct {
+		name      string
+		moves     []Move
+		expResult Result
+		expWinner rune
+	}{
+		{
+			name:      "In play",
+			moves:     repeatCycle(21),
+			expResult: ResultNone,
+		},
+		{
+			name:      "Third repetition",
+			moves:     repeatCycle(22),
+			expResult: ResultRepetition,
+		},
+		{
+			name:      "Line",
+			moves:     []Move{{X: 0, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 0}},
+			expResult: ResultNInARow,
+			expWinner: rune(SquareStateCross),
+		},
+		{
+			name: "Line broken by a disappearing piece",
+			moves: []Move{
+				{X: 0, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 0}, {X: 1, Y: 1},
+				{X: 2, Y: 2}, {X: 0, Y: 2}, {X: 2, Y: 0},
+			},
+			expResult: ResultNone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := InfiniteState{Moves: tt.moves}
+			assert.NoError(t, n.initialize())
+			result, row := n.getGameResult()
+			assert.Equal(t, tt.expResult, result)
+			if tt.expWinner != 0 {
+				assert.Equal(t, tt.expWinner, linePlayer(row))
+			}
+		})
+	}
+}
+
+func TestInfiniteEngine_ComputeMove(t *testing.T) {
+	tests := []struct {
+		name    string
+		moves   []Move
+		expMove Move
+		expEval Evaluation
+	}{
+		{
+			name:    "Win",
+			moves:   []Move{{X: 0, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 0}, {X: 1, Y: 1}},
+			expMove: Move{X: 2, Y: 0},
+			expEval: 1,
+		},
+		{
+			name:    "Block",
+			moves:   []Move{{X: 0, Y: 1}, {X: 0, Y: 0}, {X: 1, Y: 1}},
+			expMove: Move{X: 2, Y: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := InfiniteState{Moves: tt.moves}
+			assert.NoError(t, n.initialize())
+			e := &infiniteEngine{}
+			move, eval, err := e.ComputeMove(context.Background(), n)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expMove, move)
+			if tt.expEval != 0 {
+				assert.Equal(t, tt.expEval, eval)
+			}
+		})
+	}
+}
+
+func TestInfiniteEngine_PerfectPlay(t *testing.T) {
+	n := InfiniteState{}
+	assert.NoError(t, n.initialize())
+	e := &infiniteEngine{}
+	result := ResultNone
+	for result == ResultNone {
+		move, _, err := e.ComputeMove(context.Background(), n)
+		assert.NoError(t, err)
+		assert.NoError(t, n.occupyPosition(move.X, move.Y))
+		result, _ = n.getGameResult()
+	}
+
+	// The first player wins in 13 plies however the second defends.
+	assert.Equal(t, ResultNInARow, result)
+	assert.Len(t, n.Moves, 13)
+}
+
+func TestInfiniteStateHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+		expResult     Result
+		expWinner     rune
+		expTurn       int
+		expFading     *Move
+	}{
+		{
+			name:          "Computer opens",
+			body:          `{"variant": "infinite"}`,
+			expStatusCode: http.StatusOK,
+			expTurn:       2,
+		},
+		{
+			name: "Fading piece",
+			body: `{"variant": "infinite", "moves": [{"x":1,"y":1},{"x":0,"y":0},{"x":2,"y":0},` +
+				`{"x":0,"y":2},{"x":0,"y":1}]}`,
+			expStatusCode: http.StatusOK,
+			expTurn:       7,
+			expFading:     &Move{X: 1, Y: 1},
+		},
+		{
+			name:          "Computer wins",
+			body:          `{"variant": "infinite", "moves": [{"x":0,"y":0},{"x":0,"y":1},{"x":1,"y":0},{"x":1,"y":1}]}`,
+			expStatusCode: http.StatusOK,
+			expResult:     ResultNInARow,
+			expWinner:     rune(SquareStateCross),
+			expTurn:       6,
+		},
+		{
+			name:          "Human to move",
+			body:          `{"variant": "infinite", "humanPlayer": 88}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Illegal move",
+			body:          `{"variant": "infinite", "moves": [{"x":3,"y":0}]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			TicTacToeStateHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+			if tt.expStatusCode != http.StatusOK {
+				return
+			}
+
+			resp := InfiniteStateResponse{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expResult, resp.Result)
+			assert.Equal(t, tt.expWinner, resp.Winner)
+			assert.Equal(t, tt.expTurn, resp.Turn)
+			assert.Len(t, resp.Moves, tt.expTurn-1)
+			assert.Equal(t, tt.expFading, resp.Fading)
+		})
+	}
+}
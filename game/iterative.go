@@ -0,0 +1,90 @@
+package game
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// defaultTimeBudget is how long an iterative deepening search runs when the
+// request does not give a time budget.
+const defaultTimeBudget = 2 * time.Second
+
+// gomokuTimeBudget is how long the search runs on a Gomoku board when the
+// request does not give a time budget, so that the computer answers within a
+// second.
+const gomokuTimeBudget = 900 * time.Millisecond
+
+// deepen runs depth limited alpha-beta searches to successively greater
+// depths, starting each with the best move of the last. It stops once a search
+// reaches the end of the game, the time budget is spent or ctx is done, and
+// returns the score and the best move found so far, along with the symbol it
+// places when the player may choose, from the point of view of the player
+// whose turn it is. It also reports whether the score is the game-theoretic
+// value of the position rather than an estimate, and any error placing a
+// piece stops the search. A zero budget means no limit other than ctx.
+func deepen(ctx context.Context, gameState TicTacToeState, budget time.Duration) (int, Move, bool, error) {
+	return newSearch(ctx, gameState, budget).deepen(gameState)
+}
+
+// newSearch returns a search of gameState using the shared transposition
+// table, which is aborted at the end of the budget or at ctx's deadline,
+// whichever comes first.
+func newSearch(ctx context.Context, gameState TicTacToeState, budget time.Duration) *search {
+	s := &search{table: transpositions, ctx: ctx}
+	if budget > 0 {
+		s.deadline = time.Now().Add(budget)
+	}
+	if d, ok := ctx.Deadline(); ok && (s.deadline.IsZero() || d.Before(s.deadline)) {
+		s.deadline = d
+	}
+	if len(gameState.Board) >= neighbourhoodBoardSize {
+		s.checkInterval = 1
+	}
+
+	return s
+}
+
+// deepen runs the searches of deepen until s is aborted or the position is
+// solved.
+func (s *search) deepen(gameState TicTacToeState) (int, Move, bool, error) {
+	moves, empties, pruned := gameState.candidateMoves()
+	if empties == 0 {
+		return 0, Move{}, false, nil
+	} else if len(moves) == 1 && pruned {
+		// Searching deeper cannot change the only move considered, such as
+		// the centre of an empty Gomoku board, however long it runs.
+		return 0, Move{X: moves[0][0], Y: moves[0][1]}, false, nil
+	}
+	if p, ok := lookupSolved(gameState); ok {
+		x, y := p.bestMove()
+		return p.score(), Move{X: x, Y: y}, true, nil
+	}
+
+	bestScore, best := 0, Move{X: moves[0][0], Y: moves[0][1]}
+	solved := false
+	for depth := 1; depth <= empties; depth++ {
+		s.maxDepth = depth
+		s.cutoffs = 0
+		s.rootSearched = 0
+		score, x, y, err := s.alphaBeta(gameState, true, 0, -math.MaxInt32, math.MaxInt32)
+		if err != nil {
+			return 0, Move{}, false, err
+		}
+		if s.aborted {
+			if s.rootSearched > 0 {
+				bestScore, best = score, Move{X: x, Y: y, Symbol: s.symbol}
+			}
+			break
+		}
+
+		bestScore, best = score, Move{X: x, Y: y, Symbol: s.symbol}
+		s.firstX, s.firstY, s.firstSymbol, s.searchFirst = x, y, s.symbol, true
+		if s.cutoffs == 0 || isWinScore(score) {
+			solved = true
+			break
+		}
+	}
+
+	return bestScore, best, solved, nil
+}
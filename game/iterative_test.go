@@ -0,0 +1,97 @@
+package game
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeepen_AllPositions(t *testing.T) {
+	positions := make(map[string]TicTacToeState)
+	reachablePositions(TicTacToeState{Board: makeBoard(3), Turn: 1}, positions)
+
+	memo := make(map[string]int)
+	for key, gameState := range positions {
+		want := solvePosition(gameState, memo)
+		got, move, solved, err := deepen(context.Background(), gameState, 0)
+		assert.NoError(t, err, key)
+		assert.Equal(t, want, got, key)
+		assert.True(t, solved, key)
+
+		child := childPositions(gameState)[[2]int{move.X, move.Y}]
+		if assert.NotNil(t, child.Board, key) {
+			assert.Equal(t, want, childScore(child, memo), key)
+		}
+	}
+}
+
+func TestDeepen_LargeBoards(t *testing.T) {
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		wantX     int
+		wantY     int
+	}{
+		{
+			name: "Crosses Win on 7x7",
+			gameState: func() TicTacToeState {
+				board := makeBoard(7)
+				board[3][1], board[3][2], board[3][3] = SquareStateCross, SquareStateCross, SquareStateCross
+				board[0][0], board[6][6], board[3][0] = SquareStateNaught, SquareStateNaught, SquareStateNaught
+				return TicTacToeState{Board: board, WinLength: 4, Turn: 7}
+			}(),
+			wantX: 4,
+			wantY: 3,
+		},
+		{
+			name: "Naughts Defend on 7x7",
+			gameState: func() TicTacToeState {
+				board := makeBoard(7)
+				board[2][2], board[3][3], board[4][4] = SquareStateCross, SquareStateCross, SquareStateCross
+				board[1][1], board[0][6] = SquareStateNaught, SquareStateNaught
+				return TicTacToeState{Board: board, WinLength: 4, Turn: 6}
+			}(),
+			wantX: 5,
+			wantY: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, move, _, err := deepen(context.Background(), tt.gameState, time.Second)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantX, move.X)
+			assert.Equal(t, tt.wantY, move.Y)
+		})
+	}
+}
+
+func TestDeepen_TimeBudget(t *testing.T) {
+	gameState := TicTacToeState{Board: makeBoard(9), WinLength: 5, Turn: 1}
+
+	start := time.Now()
+	_, move, _, err := deepen(context.Background(), gameState, 100*time.Millisecond)
+	assert.NoError(t, err)
+	elapsed := time.Since(start)
+	t.Logf("searched for %v", elapsed)
+	assert.Less(t, int64(elapsed), int64(time.Second))
+	assert.False(t, gameState.isOccupied(move.X, move.Y))
+}
+
+func TestDeepen_Cancelled(t *testing.T) {
+	gameState := TicTacToeState{Board: makeBoard(9), WinLength: 5, Turn: 1}
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	_, move, _, err := deepen(ctx, gameState, 0)
+	assert.NoError(t, err)
+	elapsed := time.Since(start)
+	t.Logf("searched for %v", elapsed)
+	assert.Less(t, int64(elapsed), int64(time.Second))
+	assert.False(t, gameState.isOccupied(move.X, move.Y))
+}
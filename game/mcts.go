@@ -0,0 +1,275 @@
+package game
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// defaultMCTSIterations is the number of iterations the Monte Carlo tree search
+// runs when neither an iteration count nor a time budget is given.
+const defaultMCTSIterations = 10000
+
+// explorationConstant balances exploring rarely visited moves against
+// exploiting moves that have done well so far in the UCT formula.
+var explorationConstant = math.Sqrt2
+
+// position is a game the Monte Carlo tree search can play out.
+type position interface {
+	// legalMoves returns the squares the player whose turn it is may
+	// occupy.
+	legalMoves() [][2]int
+	occupyPosition(x, y int) error
+	playersTurn() rune
+	// outcome reports whether the game is over and if so the winner, or
+	// zero for a stalemate.
+	outcome() (bool, rune)
+	// outcomeAfter is outcome for a game that was still in play before the
+	// move just made at x, y, which lets it look only at what the move
+	// changed.
+	outcomeAfter(x, y int) (bool, rune)
+	copyPosition() position
+}
+
+// mctsNode is a node of the Monte Carlo search tree. Its statistics are from
+// the point of view of player, the player who made the move leading to it.
+type mctsNode struct {
+	parent   *mctsNode
+	children []*mctsNode
+	untried  [][2]int
+	x, y     int
+	player   rune
+	visits   int
+	reward   float64
+
+	// over is set on nodes where the game has ended, won by winner.
+	over   bool
+	winner rune
+}
+
+func newMCTSNode(parent *mctsNode, gameState position, x, y int, player rune) *mctsNode {
+	node := &mctsNode{
+		parent: parent,
+		x:      x,
+		y:      y,
+		player: player,
+	}
+	if parent == nil {
+		node.over, node.winner = gameState.outcome()
+	} else {
+		node.over, node.winner = gameState.outcomeAfter(x, y)
+	}
+	if !node.over {
+		node.untried = gameState.legalMoves()
+	}
+
+	return node
+}
+
+// uct returns the child with the highest upper confidence bound.
+func (n *mctsNode) uct() *mctsNode {
+	var best *mctsNode
+	bestScore := math.Inf(-1)
+	logVisits := math.Log(float64(n.visits))
+	for _, child := range n.children {
+		score := child.reward/float64(child.visits) +
+			explorationConstant*math.Sqrt(logVisits/float64(child.visits))
+		if score > bestScore {
+			best = child
+			bestScore = score
+		}
+	}
+
+	return best
+}
+
+// mctsEngine is a Monte Carlo tree search using the UCT selection policy. It
+// runs for the given number of iterations or until the time budget is spent,
+// whichever comes first. Giving it a seeded rng makes an iteration limited
+// search reproducible.
+type mctsEngine struct {
+	iterations int
+	budget     time.Duration
+	rng        *rand.Rand
+}
+
+// ComputeMove returns the most visited move. Its evaluation is based on the
+// estimated chance of the player whose turn it is winning the game, counting
+// a draw as half a win.
+func (m *mctsEngine) ComputeMove(ctx context.Context, gameState TicTacToeState) (Move, Evaluation, error) {
+	if len(gameState.legalMoves()) == 0 {
+		return Move{}, 0, ErrNoMoves
+	}
+	if gameState.sharesSymbols() {
+		winRate, x, y := m.computeMove(ctx, &wildPosition{&gameState})
+		move := wildMove(gameState.Board, x, y)
+		return move, Evaluation(2*winRate - 1), nil
+	}
+	winRate, x, y := m.computeMove(ctx, &gameState)
+
+	return Move{X: x, Y: y}, Evaluation(2*winRate - 1), nil
+}
+
+// computeMove returns the estimated chance of the player whose turn it is
+// winning the game, counting a draw as half a win, along with the coordinates
+// of the most visited move. It stops early once ctx is done.
+func (m *mctsEngine) computeMove(ctx context.Context, gameState position) (float64, int, int) {
+	iterations := m.iterations
+	if iterations == 0 && m.budget == 0 {
+		iterations = defaultMCTSIterations
+	}
+	var deadline time.Time
+	if m.budget > 0 {
+		deadline = time.Now().Add(m.budget)
+	}
+
+	// The root's player is whoever moved last, so that its children are
+	// scored from the point of view of the player whose turn it is.
+	root := newMCTSNode(nil, gameState, 0, 0, 0)
+	for i := 0; iterations == 0 || i < iterations; i++ {
+		if m.budget > 0 && time.Now().After(deadline) || ctx.Err() != nil {
+			break
+		}
+
+		gs := gameState.copyPosition()
+
+		// Selection
+		node := root
+		for len(node.untried) == 0 && len(node.children) > 0 {
+			node = node.uct()
+			_ = gs.occupyPosition(node.x, node.y)
+		}
+
+		// Expansion
+		if len(node.untried) > 0 {
+			j := m.rng.Intn(len(node.untried))
+			move := node.untried[j]
+			node.untried[j] = node.untried[len(node.untried)-1]
+			node.untried = node.untried[:len(node.untried)-1]
+
+			player := gs.playersTurn()
+			_ = gs.occupyPosition(move[0], move[1])
+			child := newMCTSNode(node, gs, move[0], move[1], player)
+			node.children = append(node.children, child)
+			node = child
+		}
+
+		// Simulation
+		winner := m.rollout(gs, node)
+
+		// Backpropagation
+		for ; node != nil; node = node.parent {
+			node.visits++
+			if winner == 0 {
+				node.reward += 0.5
+			} else if winner == node.player {
+				node.reward++
+			}
+		}
+	}
+
+	var best *mctsNode
+	for _, child := range root.children {
+		if best == nil || child.visits > best.visits {
+			best = child
+		}
+	}
+	if best == nil {
+		return 0, 0, 0
+	}
+
+	return best.reward / float64(best.visits), best.x, best.y
+}
+
+// rollout plays random moves from the position of node until the game ends
+// and returns the winner, or zero for a stalemate.
+func (m *mctsEngine) rollout(gameState position, node *mctsNode) rune {
+	over, winner := node.over, node.winner
+	for !over {
+		moves := gameState.legalMoves()
+		move := moves[m.rng.Intn(len(moves))]
+		_ = gameState.occupyPosition(move[0], move[1])
+		over, winner = gameState.outcomeAfter(move[0], move[1])
+	}
+
+	return winner
+}
+
+// legalMoves returns the squares the player whose turn it is may occupy: the
+// empty squares, or in the gravity variant the lowest empty square of each
+// column.
+func (t *TicTacToeState) legalMoves() [][2]int {
+	if t.variant() == VariantGravity {
+		return t.gravityMoves()
+	}
+
+	return t.emptySquares()
+}
+
+// outcome reports whether the game is over and if so the winner, or zero for
+// a stalemate.
+func (t *TicTacToeState) outcome() (bool, rune) {
+	return t.resultOutcome(t.getGameResult())
+}
+
+func (t *TicTacToeState) outcomeAfter(x, y int) (bool, rune) {
+	return t.resultOutcome(t.moveResult(x, y))
+}
+
+// resultOutcome converts a result and the row that concluded the game into
+// an outcome.
+func (t *TicTacToeState) resultOutcome(result Result, row [][]SquareState) (bool, rune) {
+	if result == ResultNone {
+		return false, 0
+	}
+
+	return true, t.winner(result, row)
+}
+
+func (t *TicTacToeState) copyPosition() position {
+	gs := t.clone()
+	return &gs
+}
+
+// wildPosition adapts a game in which the players share symbols, such as the
+// wild variant, to the search, whose moves are squares alone, by numbering the
+// squares as if a second board lay to the right of the first: a square on the
+// first board places a cross, and one on the second a naught.
+type wildPosition struct {
+	*TicTacToeState
+}
+
+// wildMove converts a square of a wildPosition into the move it stands for.
+func wildMove(board [][]SquareState, x, y int) Move {
+	if width := len(board[y]); x >= width {
+		return Move{X: x - width, Y: y, Symbol: SquareStateNaught}
+	}
+
+	return Move{X: x, Y: y, Symbol: SquareStateCross}
+}
+
+func (w *wildPosition) legalMoves() [][2]int {
+	squares := w.TicTacToeState.legalMoves()
+	moves := make([][2]int, 0, 2*len(squares))
+	for _, square := range squares {
+		moves = append(moves, square, [2]int{square[0] + len(w.Board[square[1]]), square[1]})
+	}
+
+	return moves
+}
+
+func (w *wildPosition) occupyPosition(x, y int) error {
+	move := wildMove(w.Board, x, y)
+	return w.placeSymbol(move.X, move.Y, move.Symbol)
+}
+
+func (w *wildPosition) outcomeAfter(x, y int) (bool, rune) {
+	move := wildMove(w.Board, x, y)
+	return w.TicTacToeState.outcomeAfter(move.X, move.Y)
+}
+
+func (w *wildPosition) copyPosition() position {
+	gs := w.clone()
+	return &wildPosition{&gs}
+}
@@ -0,0 +1,168 @@
+package game
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMCTS_ComputeMove(t *testing.T) {
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		wantX     int
+		wantY     int
+	}{
+		{
+			name: "Naughts Win",
+			gameState: TicTacToeState{
+				Turn: 6,
+				Board: [][]SquareState{
+					{SquareStateCross, SquareStateEmpty, SquareStateEmpty},
+					{SquareStateCross, SquareStateCross, SquareStateNaught},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateNaught},
+				},
+			},
+			wantX: 2,
+			wantY: 0,
+		},
+		{
+			name: "Naughts Defend",
+			gameState: TicTacToeState{
+				Turn: 4,
+				Board: [][]SquareState{
+					{SquareStateNaught, SquareStateEmpty, SquareStateEmpty},
+					{SquareStateCross, SquareStateCross, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				},
+			},
+			wantX: 2,
+			wantY: 1,
+		},
+		{
+			name: "Crosses Win on 4x4",
+			gameState: TicTacToeState{
+				Turn:      5,
+				WinLength: 3,
+				Board: [][]SquareState{
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateCross, SquareStateNaught, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateCross, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateNaught},
+				},
+			},
+			wantX: 0,
+			wantY: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mctsEngine{iterations: 5000, rng: rand.New(rand.NewSource(1))}
+			got, gotX, gotY := m.computeMove(context.Background(), &tt.gameState)
+			t.Log(got, gotX, gotY)
+			assert.Equal(t, tt.wantX, gotX)
+			assert.Equal(t, tt.wantY, gotY)
+		})
+	}
+}
+
+func TestMCTS_Reproducible(t *testing.T) {
+	gameState := TicTacToeState{Board: makeBoard(4), WinLength: 3, Turn: 1}
+	first := &mctsEngine{iterations: 2000, rng: rand.New(rand.NewSource(42))}
+	second := &mctsEngine{iterations: 2000, rng: rand.New(rand.NewSource(42))}
+
+	score, x, y := first.computeMove(context.Background(), &gameState)
+	wantScore, wantX, wantY := second.computeMove(context.Background(), &gameState)
+	assert.Equal(t, wantScore, score)
+	assert.Equal(t, wantX, x)
+	assert.Equal(t, wantY, y)
+}
+
+func TestMCTS_TimeBudget(t *testing.T) {
+	gameState := TicTacToeState{Board: makeBoard(7), WinLength: 4, Turn: 1}
+	m := &mctsEngine{budget: 50 * time.Millisecond, rng: rand.New(rand.NewSource(1))}
+
+	start := time.Now()
+	_, x, y := m.computeMove(context.Background(), &gameState)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	assert.False(t, gameState.isOccupied(x, y))
+}
+
+// playGame plays a game to the end between two engines and returns the
+// winner, or zero for a stalemate.
+func playGame(gameState TicTacToeState, crosses, naughts Engine) rune {
+	for {
+		if over, winner := gameState.outcome(); over {
+			return winner
+		}
+
+		e := crosses
+		if gameState.playersTurn() == rune(SquareStateNaught) {
+			e = naughts
+		}
+		move, _, _ := e.ComputeMove(context.Background(), gameState)
+		_ = gameState.occupyPosition(move.X, move.Y)
+	}
+}
+
+func TestMCTS_AgainstMinimax(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping games between engines in short mode")
+	}
+
+	tests := []struct {
+		name       string
+		size       int
+		winLength  int
+		mctsPlays  rune
+		minWinRate float64
+	}{
+		{
+			name:       "3x3 as crosses",
+			size:       3,
+			mctsPlays:  'X',
+			minWinRate: 0.5,
+		},
+		{
+			name:       "3x3 as naughts",
+			size:       3,
+			mctsPlays:  '0',
+			minWinRate: 0.5,
+		},
+		{
+			name:       "4x4 three in a row as crosses",
+			size:       4,
+			winLength:  3,
+			mctsPlays:  'X',
+			minWinRate: 1,
+		},
+	}
+	const games = 10
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var score float64
+			for i := 0; i < games; i++ {
+				mcts := &mctsEngine{iterations: 5000, rng: rand.New(rand.NewSource(int64(i)))}
+				crosses, naughts := Engine(mcts), Engine(&minimaxEngine{})
+				if tt.mctsPlays != 'X' {
+					crosses, naughts = naughts, crosses
+				}
+
+				gameState := TicTacToeState{Board: makeBoard(tt.size), WinLength: tt.winLength, Turn: 1}
+				switch playGame(gameState, crosses, naughts) {
+				case tt.mctsPlays:
+					score++
+				case 0:
+					score += 0.5
+				}
+			}
+
+			winRate := score / games
+			t.Logf("win rate against minimax, counting draws as half a win: %.2f", winRate)
+			assert.GreaterOrEqual(t, winRate, tt.minWinRate)
+		})
+	}
+}
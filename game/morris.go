@@ -0,0 +1,272 @@
+package game
+
+import (
+	"encoding/json"
+	"log"
+	"math/bits"
+	"net/http"
+)
+
+// morrisSize is the length of each side of a Three Men's Morris board.
+const morrisSize = 3
+
+// morrisPieces is the number of pieces each player places before they start
+// sliding them.
+const morrisPieces = 3
+
+// morrisRepetitions is the number of times the same position has to come up
+// for the game to be drawn.
+const morrisRepetitions = 3
+
+// Phase is the stage a game played in stages has reached.
+type Phase string
+
+const (
+	// PhasePlacement is the stage in which players place new pieces.
+	PhasePlacement Phase = "placement"
+	// PhaseMovement is the stage in which players move the pieces they
+	// have placed.
+	PhaseMovement Phase = "movement"
+)
+
+// MorrisState is a game of Three Men's Morris, in the style of Achi: a 3x3
+// board on which each player in turn places one of their three pieces, and
+// once all six are down, slides one of their pieces to an empty square next
+// to it along one of the board's lines, which join every square to those
+// beside it in its row and column and the centre to the corners. The first
+// player to complete a line wins, and a game that nobody wins is drawn once
+// the same position comes up for the third time. The request gives the game
+// as the Moves made since it began, each a placement or a slide From one
+// square to another.
+type MorrisState struct {
+	Moves   []Move  `json:"moves"`
+	Variant Variant `json:"variant"`
+	// The players are chosen as in infinite tic-tac-toe.
+	FirstPlayer SquareState `json:"firstPlayer,omitempty"`
+	HumanPlayer SquareState `json:"humanPlayer,omitempty"`
+
+	Board [][]SquareState `json:"-"`
+	Turn  int             `json:"-"`
+	// pieces holds masks of the squares of the first and second player's
+	// pieces, in which square x, y is bit x + 3y.
+	pieces [2]uint16
+	// seen counts the times each position has come up, keyed by key.
+	seen map[int]int
+}
+
+// MorrisStateResponse describes the game after the computer's move. Phase is
+// the stage of the game the next move belongs to.
+type MorrisStateResponse struct {
+	Board      [][]SquareState `json:"board"`
+	Moves      []Move          `json:"moves"`
+	Result     Result          `json:"result,omitempty"`
+	WinningRow [][]SquareState `json:"winningRow,omitempty"`
+	Winner     rune            `json:"winner,omitempty"`
+	Turn       int             `json:"turn"`
+	NextPlayer rune            `json:"nextPlayer"`
+	Phase      Phase           `json:"phase"`
+}
+
+// morrisStateHandler serves game state requests for Three Men's Morris, whose
+// body b holds a MorrisState, responding with a MorrisStateResponse after the
+// computer's move.
+func morrisStateHandler(w http.ResponseWriter, r *http.Request, b []byte) {
+	req := &MorrisState{}
+	err := json.Unmarshal(b, req)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "could not interpret request", err)
+		return
+	}
+
+	err = req.initialize()
+	if err != nil {
+		writeHTTPError(w, errorStatus(err), "invalid game settings", err)
+		return
+	}
+
+	result, _ := req.getGameResult()
+	if result == ResultNone && SquareState(req.playersTurn()) == req.HumanPlayer {
+		writeHTTPError(w, errorStatus(ErrNotComputersTurn), "invalid game state", ErrNotComputersTurn)
+		return
+	}
+	if result == ResultNone {
+		engine := &morrisEngine{}
+		move, _, err := engine.ComputeMove(r.Context(), *req)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to compute move", err)
+			return
+		}
+		err = req.makeMove(move)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to set board", err)
+			return
+		}
+	}
+
+	result, winningRow := req.getGameResult()
+	resp := MorrisStateResponse{
+		Board:      req.Board,
+		Moves:      req.Moves,
+		Result:     result,
+		WinningRow: winningRow,
+		Turn:       req.Turn,
+		NextPlayer: req.playersTurn(),
+		Phase:      req.phase(),
+	}
+	if result == ResultNInARow {
+		resp.Winner = linePlayer(winningRow)
+	}
+
+	b, err = json.Marshal(resp)
+	if err != nil {
+		writeHTTPError(w, http.StatusInternalServerError, "failed to marshal response", err)
+		return
+	}
+
+	_, err = w.Write(b)
+	if err != nil {
+		log.Print(err)
+	}
+}
+
+// initialize prepares a Three Men's Morris state received in a request by
+// replaying its moves on an empty board, checking that each of them was legal
+// and that none was made after the game ended.
+func (m *MorrisState) initialize() error {
+	if !isPlayer(m.FirstPlayer) && m.FirstPlayer != SquareStateEmpty ||
+		!isPlayer(m.HumanPlayer) && m.HumanPlayer != SquareStateEmpty {
+		return ErrInvalidPlayer
+	}
+
+	moves := m.Moves
+	m.Moves = nil
+	m.Board = makeBoard(morrisSize)
+	m.Turn = 1
+	m.pieces = [2]uint16{}
+	m.seen = map[int]int{m.key(): 1}
+	for _, move := range moves {
+		if result, _ := m.getGameResult(); result != ResultNone {
+			return ErrGameOver
+		}
+		err := m.makeMove(move)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MorrisState) playersTurn() rune {
+	first := opener(m.FirstPlayer)
+	if m.Turn%2 == 1 {
+		return rune(first)
+	}
+
+	return rune(opponent(first))
+}
+
+// moverIndex returns the index into pieces of the player whose turn it is.
+func (m *MorrisState) moverIndex() int {
+	return 1 - m.Turn%2
+}
+
+// phase returns the stage of the game the next move belongs to.
+func (m *MorrisState) phase() Phase {
+	if bits.OnesCount16(m.pieces[m.moverIndex()]) < morrisPieces {
+		return PhasePlacement
+	}
+
+	return PhaseMovement
+}
+
+// makeMove makes move for the player whose turn it is, placing a piece on
+// its square or sliding one there From another.
+func (m *MorrisState) makeMove(move Move) error {
+	if move.From == nil {
+		return m.occupyPosition(move.X, move.Y)
+	}
+
+	return m.slidePiece(move.From.X, move.From.Y, move.X, move.Y)
+}
+
+// occupyPosition places a new piece on square x, y for the player whose turn
+// it is.
+func (m *MorrisState) occupyPosition(x, y int) error {
+	if m.phase() != PhasePlacement {
+		return ErrAllPiecesPlaced
+	}
+	if !onMorrisBoard(x, y) {
+		return ErrOutOfBounds
+	}
+	if m.Board[y][x] != SquareStateEmpty {
+		return ErrOccupied
+	}
+
+	m.pieces[m.moverIndex()] |= 1 << uint(x+y*morrisSize)
+	m.Board[y][x] = SquareState(m.playersTurn())
+	m.Moves = append(m.Moves, Move{X: x, Y: y})
+	m.Turn++
+	m.seen[m.key()]++
+
+	return nil
+}
+
+// slidePiece moves the piece of the player whose turn it is on square fx, fy
+// to the empty square x, y next to it.
+func (m *MorrisState) slidePiece(fx, fy, x, y int) error {
+	if m.phase() != PhaseMovement {
+		return ErrPiecesToPlace
+	}
+	if !onMorrisBoard(fx, fy) || !onMorrisBoard(x, y) {
+		return ErrOutOfBounds
+	}
+	if m.Board[fy][fx] != SquareState(m.playersTurn()) {
+		return ErrNoPieceToMove
+	}
+	if m.Board[y][x] != SquareStateEmpty {
+		return ErrOccupied
+	}
+	if !morrisAdjacent(fx+fy*morrisSize, x+y*morrisSize) {
+		return ErrNotAdjacent
+	}
+
+	m.pieces[m.moverIndex()] ^= 1<<uint(fx+fy*morrisSize) | 1<<uint(x+y*morrisSize)
+	m.Board[y][x] = m.Board[fy][fx]
+	m.Board[fy][fx] = SquareStateEmpty
+	m.Moves = append(m.Moves, Move{X: x, Y: y, From: &Square{X: fx, Y: fy}})
+	m.Turn++
+	m.seen[m.key()]++
+
+	return nil
+}
+
+// onMorrisBoard reports whether x, y is a square of a Three Men's Morris
+// board.
+func onMorrisBoard(x, y int) bool {
+	return x >= 0 && x < morrisSize && y >= 0 && y < morrisSize
+}
+
+// key identifies the position: the squares of each player's pieces and whose
+// turn it is.
+func (m *MorrisState) key() int {
+	mover := m.moverIndex()
+	return morrisKey(m.pieces[mover], m.pieces[1-mover])*2 + mover
+}
+
+// getGameResult calculates the current state of the game returning the result
+// and the row that concluded the game if there is a complete row, nil
+// otherwise. A game that nobody has won is drawn by repetition once the
+// current position has come up three times. Nobody is ever left without a
+// move, since some piece can always slide to an empty square unless a line
+// has already ended the game.
+func (m *MorrisState) getGameResult() (Result, [][]SquareState) {
+	lines := &TicTacToeState{Board: m.Board, Turn: bits.OnesCount16(m.pieces[0]|m.pieces[1]) + 1}
+	if result, row := lines.getGameResult(); result != ResultNone {
+		return result, row
+	}
+	if m.seen[m.key()] >= morrisRepetitions {
+		return ResultRepetition, nil
+	}
+	return ResultNone, nil
+}
@@ -0,0 +1,155 @@
+package game
+
+import (
+	"context"
+	"math/bits"
+	"sync"
+)
+
+// morrisKeys is the number of keys morrisKey can return.
+const morrisKeys = 1 << (2 * morrisSize * morrisSize)
+
+var (
+	morrisOnce sync.Once
+	// morrisSolution holds the solution of every position of Three Men's
+	// Morris by morrisKey, as solveRetrograde returns it.
+	morrisSolution []int16
+)
+
+// morrisKey identifies the position in which the player to move has pieces
+// on the squares of the mask mine and their opponent on those of theirs.
+func morrisKey(mine, theirs uint16) int {
+	return int(mine)<<(morrisSize*morrisSize) | int(theirs)
+}
+
+// morrisAdjacent reports whether a piece can slide between the squares from
+// and to, each given as x + 3y: whether they are next to each other in a row
+// or column, or along a diagonal through the centre.
+func morrisAdjacent(from, to int) bool {
+	dx, dy := to%morrisSize-from%morrisSize, to/morrisSize-from/morrisSize
+	if from == to || dx < -1 || dx > 1 || dy < -1 || dy > 1 {
+		return false
+	}
+	centre := morrisSize * morrisSize / 2
+
+	return dx == 0 || dy == 0 || from == centre || to == centre
+}
+
+// morrisMoves returns the moves open to the player with pieces on the squares
+// of the mask mine against an opponent with pieces on those of theirs, each
+// as the square the piece comes from, -1 for a new piece, and the square it
+// goes to, squares given as x + 3y.
+func morrisMoves(mine, theirs uint16) [][2]int {
+	occupied := mine | theirs
+	var moves [][2]int
+	for to := 0; to < morrisSize*morrisSize; to++ {
+		if occupied&(1<<uint(to)) != 0 {
+			continue
+		}
+		if bits.OnesCount16(mine) < morrisPieces {
+			moves = append(moves, [2]int{-1, to})
+			continue
+		}
+		for from := 0; from < morrisSize*morrisSize; from++ {
+			if mine&(1<<uint(from)) != 0 && morrisAdjacent(from, to) {
+				moves = append(moves, [2]int{from, to})
+			}
+		}
+	}
+
+	return moves
+}
+
+// morrisPlay returns the mask of the pieces mine after move, as morrisMoves
+// gives it.
+func morrisPlay(mine uint16, move [2]int) uint16 {
+	if move[0] >= 0 {
+		mine &^= 1 << uint(move[0])
+	}
+
+	return mine | 1<<uint(move[1])
+}
+
+// solveMorris works out morrisSolution by retrograde analysis of every
+// position in which the players have placed their pieces on distinct squares.
+func solveMorris() {
+	var positions []retrogradePosition
+	for mine := uint16(0); mine < 1<<(morrisSize*morrisSize); mine++ {
+		a := bits.OnesCount16(mine)
+		if a > morrisPieces {
+			continue
+		}
+		for theirs := uint16(0); theirs < 1<<(morrisSize*morrisSize); theirs++ {
+			// The opponent has placed as many pieces as the player to
+			// move, or one more when they moved first.
+			b := bits.OnesCount16(theirs)
+			if mine&theirs != 0 || b < a || b > a+1 || b > morrisPieces {
+				continue
+			}
+			p := retrogradePosition{key: morrisKey(mine, theirs)}
+			for _, move := range morrisMoves(mine, theirs) {
+				placed := morrisPlay(mine, move)
+				if isDeadNotaktoMask(placed) {
+					p.winNow = true
+					break
+				}
+				p.successors = append(p.successors, morrisKey(theirs, placed))
+			}
+			positions = append(positions, p)
+		}
+	}
+
+	morrisSolution = solveRetrograde(positions, morrisKeys)
+}
+
+// morrisEngine plays perfect Three Men's Morris by the solution of every
+// position, which it works out the first time it is asked for a move, in the
+// same way as infiniteEngine.
+type morrisEngine struct{}
+
+func (e *morrisEngine) ComputeMove(ctx context.Context, m MorrisState) (Move, Evaluation, error) {
+	morrisOnce.Do(solveMorris)
+
+	mover := m.moverIndex()
+	mine, theirs := m.pieces[mover], m.pieces[1-mover]
+	var best *Move
+	bestValue := 0
+	for _, move := range morrisMoves(mine, theirs) {
+		// The value of the move for the player making it, ranked as the
+		// solution is.
+		placed := morrisPlay(mine, move)
+		value := 1
+		if !isDeadNotaktoMask(placed) {
+			key := morrisKey(theirs, placed)
+			switch v := int(morrisSolution[key]); {
+			case m.seen[key*2+1-mover] >= morrisRepetitions-1:
+				value = 0
+			case v < 0:
+				value = 1 - v
+			case v > 0:
+				value = -1 - v
+			default:
+				value = 0
+			}
+		}
+		if best == nil || plyRank(value) > plyRank(bestValue) {
+			best = &Move{X: move[1] % morrisSize, Y: move[1] / morrisSize}
+			if move[0] >= 0 {
+				best.From = &Square{X: move[0] % morrisSize, Y: move[0] / morrisSize}
+			}
+			bestValue = value
+		}
+	}
+	if best == nil {
+		return Move{}, 0, ErrNoMoves
+	}
+
+	switch {
+	case bestValue > 0:
+		return *best, 1, nil
+	case bestValue < 0:
+		return *best, -1, nil
+	}
+
+	return *best, 0, nil
+}
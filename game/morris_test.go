@@ -0,0 +1,266 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// morrisPlacements place crosses in the corners at 2, 0, at 0, 2 and at 2, 2
+// and naughts at 0, 0, at 1, 0 and at 0, 1, after which crosses can win by
+// sliding to the centre.
+var morrisPlacements = []Move{
+	{X: 2, Y: 0}, {X: 0, Y: 0}, {X: 0, Y: 2}, {X: 1, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 1},
+}
+
+// morrisShuffle is a round of slides back and forth after morrisPlacements,
+// which brings the position after them up again.
+var morrisShuffle = []Move{
+	{X: 1, Y: 2, From: &Square{X: 2, Y: 2}}, {X: 1, Y: 1, From: &Square{X: 0, Y: 1}},
+	{X: 2, Y: 2, From: &Square{X: 1, Y: 2}}, {X: 0, Y: 1, From: &Square{X: 1, Y: 1}},
+}
+
+// morrisMovesWith returns morrisPlacements followed by moves.
+func morrisMovesWith(moves ...Move) []Move {
+	return append(append([]Move(nil), morrisPlacements...), moves...)
+}
+
+func TestMorris_Initialize(t *testing.T) {
+	tests := []struct {
+		name     string
+		moves    []Move
+		expErr   error
+		expPhase Phase
+	}{
+		{
+			name:     "Empty board",
+			expPhase: PhasePlacement,
+		},
+		{
+			name:     "All pieces placed",
+			moves:    morrisPlacements,
+			expPhase: PhaseMovement,
+		},
+		{
+			name:   "Slide before all pieces are placed",
+			moves:  []Move{{X: 1, Y: 1}, {X: 0, Y: 0}, {X: 1, Y: 0, From: &Square{X: 1, Y: 1}}},
+			expErr: ErrPiecesToPlace,
+		},
+		{
+			name:   "Fourth piece",
+			moves:  morrisMovesWith(Move{X: 1, Y: 1}),
+			expErr: ErrAllPiecesPlaced,
+		},
+		{
+			name:   "Opponent's piece",
+			moves:  morrisMovesWith(Move{X: 1, Y: 1, From: &Square{X: 0, Y: 0}}),
+			expErr: ErrNoPieceToMove,
+		},
+		{
+			name:   "Onto a piece",
+			moves:  morrisMovesWith(Move{X: 1, Y: 0, From: &Square{X: 2, Y: 0}}),
+			expErr: ErrOccupied,
+		},
+		{
+			name:   "Not adjacent",
+			moves:  morrisMovesWith(Move{X: 2, Y: 1, From: &Square{X: 0, Y: 2}}),
+			expErr: ErrNotAdjacent,
+		},
+		{
+			name:   "Off the board",
+			moves:  morrisMovesWith(Move{X: 3, Y: 2, From: &Square{X: 2, Y: 2}}),
+			expErr: ErrOutOfBounds,
+		},
+		{
+			name:   "Move after a win",
+			moves:  morrisMovesWith(Move{X: 1, Y: 1, From: &Square{X: 2, Y: 2}}, Move{X: 2, Y: 1, From: &Square{X: 1, Y: 0}}),
+			expErr: ErrGameOver,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := MorrisState{Moves: tt.moves}
+			err := m.initialize()
+			assert.ErrorIs(t, err, tt.expErr)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.expPhase, m.phase())
+			assert.Equal(t, len(tt.moves)+1, m.Turn)
+		})
+	}
+}
+
+func TestMorrisAdjacent(t *testing.T) {
+	tests := []struct {
+		from, to int
+		exp      bool
+	}{
+		{from: 0, to: 1, exp: true},
+		{from: 0, to: 3, exp: true},
+		{from: 0, to: 4, exp: true},
+		{from: 4, to: 8, exp: true},
+		{from: 1, to: 3},
+		{from: 5, to: 7},
+		{from: 0, to: 2},
+		{from: 2, to: 3},
+		{from: 4, to: 4},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.exp, morrisAdjacent(tt.from, tt.to), "%d to %d", tt.from, tt.to)
+		assert.Equal(t, tt.exp, morrisAdjacent(tt.to, tt.from), "%d to %d", tt.to, tt.from)
+	}
+}
+
+func TestMorris_GetGameResult(t *testing.T) {
+	tests := []struct {
+		name      string
+		moves     []Move
+		expResult Result
+		expWinner rune
+	}{
+		{
+			name:      "In play",
+			moves:     morrisMovesWith(append(morrisShuffle, morrisShuffle[:3]...)...),
+			expResult: ResultNone,
+		},
+		{
+			name:      "Third repetition",
+			moves:     morrisMovesWith(append(morrisShuffle, morrisShuffle...)...),
+			expResult: ResultRepetition,
+		},
+		{
+			name:      "Line made by sliding",
+			moves:     morrisMovesWith(Move{X: 1, Y: 1, From: &Square{X: 2, Y: 2}}),
+			expResult: ResultNInARow,
+			expWinner: rune(SquareStateCross),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := MorrisState{Moves: tt.moves}
+			assert.NoError(t, m.initialize())
+			result, row := m.getGameResult()
+			assert.Equal(t, tt.expResult, result)
+			if tt.expWinner != 0 {
+				assert.Equal(t, tt.expWinner, linePlayer(row))
+			}
+		})
+	}
+}
+
+func TestMorrisEngine_ComputeMove(t *testing.T) {
+	tests := []struct {
+		name    string
+		moves   []Move
+		expMove Move
+		expEval Evaluation
+	}{
+		{
+			name:    "Win by sliding",
+			moves:   morrisPlacements,
+			expMove: Move{X: 1, Y: 1, From: &Square{X: 2, Y: 2}},
+			expEval: 1,
+		},
+		{
+			name:    "Block the centre",
+			moves:   morrisPlacements[:5],
+			expMove: Move{X: 1, Y: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := MorrisState{Moves: tt.moves}
+			assert.NoError(t, m.initialize())
+			e := &morrisEngine{}
+			move, eval, err := e.ComputeMove(context.Background(), m)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expMove, move)
+			if tt.expEval != 0 {
+				assert.Equal(t, tt.expEval, eval)
+			}
+		})
+	}
+}
+
+func TestMorrisEngine_PerfectPlay(t *testing.T) {
+	m := MorrisState{}
+	assert.NoError(t, m.initialize())
+	e := &morrisEngine{}
+	result := ResultNone
+	for result == ResultNone {
+		move, _, err := e.ComputeMove(context.Background(), m)
+		assert.NoError(t, err)
+		assert.NoError(t, m.makeMove(move))
+		result, _ = m.getGameResult()
+	}
+
+	// The first player wins in 9 plies however the second defends.
+	assert.Equal(t, ResultNInARow, result)
+	assert.Len(t, m.Moves, 9)
+}
+
+func TestMorrisStateHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+		expResult     Result
+		expWinner     rune
+		expTurn       int
+		expPhase      Phase
+	}{
+		{
+			name:          "Computer opens",
+			body:          `{"variant": "morris"}`,
+			expStatusCode: http.StatusOK,
+			expTurn:       2,
+			expPhase:      PhasePlacement,
+		},
+		{
+			name: "Computer wins by sliding",
+			body: `{"variant": "morris", "moves": [{"x":2,"y":0},{"x":0,"y":0},{"x":0,"y":2},` +
+				`{"x":1,"y":0},{"x":2,"y":2},{"x":0,"y":1}]}`,
+			expStatusCode: http.StatusOK,
+			expResult:     ResultNInARow,
+			expWinner:     rune(SquareStateCross),
+			expTurn:       8,
+			expPhase:      PhaseMovement,
+		},
+		{
+			name:          "Human to move",
+			body:          `{"variant": "morris", "humanPlayer": 88}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Slide too early",
+			body:          `{"variant": "morris", "moves": [{"x":1,"y":1},{"x":0,"y":0,"from":{"x":1,"y":0}}]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			TicTacToeStateHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+			if tt.expStatusCode != http.StatusOK {
+				return
+			}
+
+			resp := MorrisStateResponse{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expResult, resp.Result)
+			assert.Equal(t, tt.expWinner, resp.Winner)
+			assert.Equal(t, tt.expTurn, resp.Turn)
+			assert.Len(t, resp.Moves, tt.expTurn-1)
+			assert.Equal(t, tt.expPhase, resp.Phase)
+		})
+	}
+}
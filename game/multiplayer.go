@@ -0,0 +1,232 @@
+package game
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+)
+
+// Board size and win length of the multiplayer variant when the request does
+// not give them.
+const (
+	multiplayerBoardSize = 6
+	multiplayerWinLength = 4
+)
+
+// maxPlayers is the most players a multiplayer game may have.
+const maxPlayers = 8
+
+// defaultPlayers are the players of a multiplayer game, in turn order, when
+// the request does not give them.
+var defaultPlayers = []SquareState{SquareStateCross, SquareStateNaught, SquareStateDelta}
+
+// MultiplayerState is an N by N board on which two or more Players, given by
+// their symbols in turn order, take turns to place their pieces, and the
+// first to complete a line of WinLength squares wins. The computer plays
+// whichever player's turn it is unless that is HumanPlayer.
+type MultiplayerState struct {
+	Board       [][]SquareState `json:"board"`
+	Size        int             `json:"size,omitempty"`
+	WinLength   int             `json:"winLength,omitempty"`
+	Variant     Variant         `json:"variant"`
+	Players     []SquareState   `json:"players,omitempty"`
+	HumanPlayer SquareState     `json:"humanPlayer,omitempty"`
+	TimeBudget  int             `json:"timeBudget,omitempty"` // milliseconds
+	Turn        int             `json:"-"`
+}
+
+type MultiplayerStateResponse struct {
+	Board      [][]SquareState `json:"board"`
+	Result     Result          `json:"result,omitempty"`
+	WinningRow [][]SquareState `json:"winningRow,omitempty"`
+	Winner     rune            `json:"winner,omitempty"`
+	Turn       int             `json:"turn"`
+	NextPlayer rune            `json:"nextPlayer"`
+	Players    []SquareState   `json:"players"`
+	WinLength  int             `json:"winLength"`
+}
+
+// multiplayerStateHandler serves game state requests for the multiplayer
+// variant, whose body b holds a MultiplayerState, responding with a
+// MultiplayerStateResponse after the computer's move.
+func multiplayerStateHandler(w http.ResponseWriter, r *http.Request, b []byte) {
+	req := &MultiplayerState{}
+	err := json.Unmarshal(b, req)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "could not interpret request", err)
+		return
+	}
+
+	err = req.initialize()
+	if err != nil {
+		writeHTTPError(w, errorStatus(err), "invalid game settings", err)
+		return
+	}
+	if req.TimeBudget < 0 {
+		writeHTTPError(w, errorStatus(ErrInvalidSearchLimit), "invalid engine", ErrInvalidSearchLimit)
+		return
+	}
+
+	result, _ := req.getGameResult()
+	if result == ResultNone && SquareState(req.playersTurn()) == req.HumanPlayer {
+		writeHTTPError(w, errorStatus(ErrNotComputersTurn), "invalid game state", ErrNotComputersTurn)
+		return
+	}
+	if result == ResultNone {
+		engine := &multiplayerEngine{budget: time.Duration(req.TimeBudget) * time.Millisecond}
+		move, _, err := engine.ComputeMove(r.Context(), *req)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to compute move", err)
+			return
+		}
+		err = req.occupyPosition(move.X, move.Y)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to set board", err)
+			return
+		}
+	}
+
+	result, winningRow := req.getGameResult()
+	resp := MultiplayerStateResponse{
+		Board:      req.Board,
+		Result:     result,
+		WinningRow: winningRow,
+		Turn:       req.Turn,
+		NextPlayer: req.playersTurn(),
+		Players:    req.Players,
+		WinLength:  req.WinLength,
+	}
+	if result == ResultNInARow {
+		resp.Winner = linePlayer(winningRow)
+	}
+
+	b, err = json.Marshal(resp)
+	if err != nil {
+		writeHTTPError(w, http.StatusInternalServerError, "failed to marshal response", err)
+		return
+	}
+
+	_, err = w.Write(b)
+	if err != nil {
+		log.Print(err)
+	}
+}
+
+// initialize prepares a multiplayer state received in a request, creating an
+// empty board of the requested size when no board was given, and checking
+// that the players are distinct, that the board holds nothing but their
+// pieces, that they have taken turns in order and that play could have led to
+// the board.
+func (m *MultiplayerState) initialize() error {
+	if len(m.Players) == 0 {
+		m.Players = append([]SquareState(nil), defaultPlayers...)
+	}
+	if len(m.Players) < 2 || len(m.Players) > maxPlayers {
+		return ErrInvalidPlayerCount
+	}
+	pieces := make(map[SquareState]int, len(m.Players))
+	for _, player := range m.Players {
+		if _, dup := pieces[player]; dup || player == SquareStateEmpty {
+			return ErrInvalidPlayer
+		}
+		pieces[player] = 0
+	}
+	if _, ok := pieces[m.HumanPlayer]; !ok && m.HumanPlayer != SquareStateEmpty {
+		return ErrInvalidPlayer
+	}
+
+	if len(m.Board) == 0 {
+		if m.Size == 0 {
+			m.Size = multiplayerBoardSize
+		}
+		if m.Size < 1 || m.Size > maxBoardSize {
+			return ErrInvalidBoardSize
+		}
+		m.Board = makeBoard(m.Size)
+	}
+	if m.Size != 0 && m.Size != len(m.Board) || len(m.Board) > maxBoardSize {
+		return ErrInvalidBoardSize
+	}
+	m.Size = len(m.Board)
+	if m.WinLength == 0 {
+		m.WinLength = minInt(multiplayerWinLength, m.Size)
+	}
+	if m.WinLength < 1 || m.WinLength > m.Size {
+		return ErrInvalidWinLength
+	}
+
+	m.Turn = 1
+	for _, row := range m.Board {
+		if len(row) != m.Size {
+			return ErrInvalidBoardSize
+		}
+		for _, square := range row {
+			if square == SquareStateEmpty {
+				continue
+			}
+			if _, ok := pieces[square]; !ok {
+				return ErrInvalidSymbol
+			}
+			pieces[square]++
+			m.Turn++
+		}
+	}
+	// The players before the one whose turn it is have placed one piece
+	// more than the rest.
+	first := pieces[m.Players[0]]
+	for i, player := range m.Players[1:] {
+		if n := pieces[player]; n != first && n != first-1 || n > pieces[m.Players[i]] {
+			return ErrTurnImbalance
+		}
+	}
+	// Only the player who moved last can have completed a line, with which
+	// the game ended.
+	last := m.Players[(m.Turn+len(m.Players)-2)%len(m.Players)]
+	for player := range m.lines().linePlayers() {
+		if player != last {
+			return ErrUnreachablePosition
+		}
+	}
+
+	return nil
+}
+
+// playersTurn returns the player whose turn it is.
+func (m *MultiplayerState) playersTurn() rune {
+	return rune(m.Players[(m.Turn-1)%len(m.Players)])
+}
+
+func (m *MultiplayerState) occupyPosition(x, y int) error {
+	if y < 0 || y >= len(m.Board) || x < 0 || x >= len(m.Board[y]) {
+		return ErrOutOfBounds
+	}
+	if m.Board[y][x] != SquareStateEmpty {
+		return ErrOccupied
+	}
+
+	m.Board[y][x] = SquareState(m.playersTurn())
+	m.Turn++
+
+	return nil
+}
+
+// lines returns the game as a TicTacToeState, whose line detection works for
+// the pieces of any player.
+func (m *MultiplayerState) lines() *TicTacToeState {
+	return &TicTacToeState{Board: m.Board, WinLength: m.WinLength, Turn: m.Turn}
+}
+
+// getGameResult calculates the current state of the game returning the result
+// and the row that concluded the game if there is a complete row, nil
+// otherwise. The player whose pieces make up the row won.
+func (m *MultiplayerState) getGameResult() (Result, [][]SquareState) {
+	return m.lines().getGameResult()
+}
+
+func (m *MultiplayerState) clone() MultiplayerState {
+	c := *m
+	c.Board = copyBoard(m.Board)
+
+	return c
+}
@@ -0,0 +1,184 @@
+package game
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// multiplayerEngine plays the move found by an iterative deepening paranoid
+// search, in which the player to move assumes that all the other players are
+// out to beat them. That makes the game one of two sides, the player against
+// everyone else, to which alpha-beta pruning applies as it does in minimax.
+// Positions at the search's depth limit are scored by their open lines.
+type multiplayerEngine struct {
+	budget time.Duration
+}
+
+func (e *multiplayerEngine) ComputeMove(ctx context.Context, m MultiplayerState) (Move, Evaluation, error) {
+	moves, empties, _ := m.lines().candidateMoves()
+	if empties == 0 || len(moves) == 0 {
+		return Move{}, 0, ErrNoMoves
+	}
+	budget := e.budget
+	if budget == 0 {
+		budget = defaultTimeBudget
+	}
+
+	gs := m.clone()
+	s := &multiplayerSearch{search: search{ctx: ctx}, player: SquareState(m.playersTurn())}
+	s.deadline = time.Now().Add(budget)
+	bestScore, best := 0, Move{X: moves[0][0], Y: moves[0][1]}
+	for depth := 1; depth <= empties; depth++ {
+		s.maxDepth = depth
+		s.cutoffs = 0
+		s.rootSearched = 0
+		score, x, y := s.alphaBeta(&gs, 0, -math.MaxInt32, math.MaxInt32)
+		if s.aborted {
+			if s.rootSearched > 0 {
+				bestScore, best = score, Move{X: x, Y: y}
+			}
+			break
+		}
+
+		bestScore, best = score, Move{X: x, Y: y}
+		s.firstX, s.firstY, s.searchFirst = x, y, true
+		if s.cutoffs == 0 || isWinScore(score) {
+			break
+		}
+	}
+
+	return best, Evaluation(bestScore) / scoreWin, nil
+}
+
+// multiplayerSearch holds the state of a single paranoid search.
+type multiplayerSearch struct {
+	search
+
+	// player is the player the search is for, who maximizes the score
+	// while every other player minimizes it.
+	player SquareState
+}
+
+// alphaBeta searches the position, returning its score from the point of view
+// of s.player along with the square of the best move for the player whose
+// turn it is. It plays moves on m's board and takes them back before it
+// returns.
+func (s *multiplayerSearch) alphaBeta(m *MultiplayerState, depth, alpha, beta int) (int, int, int) {
+	if s.checkAbort() {
+		return 0, 0, 0
+	}
+	s.nodes++
+	if depth >= s.maxDepth {
+		s.cutoffs++
+		return m.heuristic(s.player), 0, 0
+	}
+
+	moves, _, pruned := m.lines().candidateMoves()
+	if len(moves) == 0 {
+		return 0, 0, 0
+	}
+	if pruned {
+		s.cutoffs++
+	}
+	if depth == 0 {
+		moves = s.rootMoves(moves)
+	}
+	mover := SquareState(m.playersTurn())
+	isMax := mover == s.player
+	best, bestX, bestY := math.MaxInt32, moves[0][0], moves[0][1]
+	if isMax {
+		best = -math.MaxInt32
+	}
+	for _, move := range moves {
+		x, y := move[0], move[1]
+		m.Board[y][x] = mover
+		m.Turn++
+
+		var r int
+		result, _ := m.lines().moveResult(x, y)
+		switch result {
+		case ResultNInARow:
+			r = scoreWin - depth
+			if !isMax {
+				r = -r
+			}
+		case ResultStalemate:
+			r = 0
+		default:
+			r, _, _ = s.alphaBeta(m, depth+1, alpha, beta)
+		}
+
+		m.Board[y][x] = SquareStateEmpty
+		m.Turn--
+		if s.aborted {
+			return best, bestX, bestY
+		}
+		if depth == 0 {
+			s.rootSearched++
+		}
+
+		if isMax && r > best || !isMax && r < best {
+			best, bestX, bestY = r, x, y
+		}
+		if isMax && best > alpha {
+			alpha = best
+		} else if !isMax && best < beta {
+			beta = best
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+
+	return best, bestX, bestY
+}
+
+// heuristic estimates the value of a position to player for use where the
+// search is cut off before the end of the game. Every line of winLength
+// squares that only one player has pieces in is still open to that player,
+// and counts towards player when the pieces are theirs and against them when
+// they are anyone else's, more so the more pieces it holds.
+func (m *MultiplayerState) heuristic(player SquareState) int {
+	k := m.WinLength
+	score := 0
+	for _, d := range lineDirections {
+		for y := range m.Board {
+			for x := range m.Board[y] {
+				ex, ey := x+(k-1)*d[0], y+(k-1)*d[1]
+				if ey >= len(m.Board) || ex < 0 || ex >= len(m.Board[ey]) {
+					continue
+				}
+
+				owner, pieces := SquareStateEmpty, 0
+				for i := 0; i < k && pieces >= 0; i++ {
+					switch square := m.Board[y+i*d[1]][x+i*d[0]]; {
+					case square == SquareStateEmpty:
+					case owner == SquareStateEmpty || square == owner:
+						owner = square
+						pieces++
+					default:
+						// The line is blocked for everyone.
+						pieces = -1
+					}
+				}
+
+				switch {
+				case pieces <= 0:
+				case owner == player:
+					score += lineWeights[minInt(pieces, len(lineWeights)-1)]
+				default:
+					score -= lineWeights[minInt(pieces, len(lineWeights)-1)]
+				}
+			}
+		}
+	}
+
+	if score > maxHeuristic {
+		return maxHeuristic
+	} else if score < -maxHeuristic {
+		return -maxHeuristic
+	}
+
+	return score
+}
@@ -0,0 +1,234 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// makeMultiplayerState returns an empty board of the given size for crosses,
+// naughts and deltas, in that order, with their pieces on the given squares,
+// each given as x, y.
+func makeMultiplayerState(size, winLength int, pieces map[SquareState][][2]int) MultiplayerState {
+	m := MultiplayerState{Board: makeBoard(size), WinLength: winLength, Variant: VariantMultiplayer}
+	for player, squares := range pieces {
+		for _, s := range squares {
+			m.Board[s[1]][s[0]] = player
+		}
+	}
+	if err := m.initialize(); err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func TestMultiplayer_Initialize(t *testing.T) {
+	x, o, d := SquareStateCross, SquareStateNaught, SquareStateDelta
+	tests := []struct {
+		name          string
+		gameState     MultiplayerState
+		expErr        error
+		expTurn       int
+		expNextPlayer SquareState
+	}{
+		{
+			name:          "Defaults",
+			gameState:     MultiplayerState{},
+			expTurn:       1,
+			expNextPlayer: x,
+		},
+		{
+			name:          "Third player to move",
+			gameState:     MultiplayerState{Board: [][]SquareState{{x, o, 0}, {0, 0, 0}, {0, 0, 0}}},
+			expTurn:       3,
+			expNextPlayer: d,
+		},
+		{
+			name:          "Round complete",
+			gameState:     MultiplayerState{Board: [][]SquareState{{x, o, d}, {0, 0, 0}, {0, 0, 0}}},
+			expTurn:       4,
+			expNextPlayer: x,
+		},
+		{
+			name:          "Chosen symbols and turn order",
+			gameState:     MultiplayerState{Board: [][]SquareState{{'A', 0, 0}, {0, 0, 0}, {0, 0, 0}}, Players: []SquareState{'A', 'B', 'C', 'D'}},
+			expTurn:       2,
+			expNextPlayer: 'B',
+		},
+		{
+			name:          "Line of the player who moved last",
+			gameState:     MultiplayerState{Board: [][]SquareState{{x, x, x}, {o, o, 0}, {d, d, 0}}},
+			expTurn:       8,
+			expNextPlayer: o,
+		},
+		{
+			name:      "Lines for every player",
+			gameState: MultiplayerState{Board: [][]SquareState{{x, x, x}, {o, o, o}, {d, d, d}}},
+			expErr:    ErrUnreachablePosition,
+		},
+		{
+			name:      "Line of a player who did not move last",
+			gameState: MultiplayerState{Board: [][]SquareState{{x, x, x}, {o, o, d}, {d, o, 0}}},
+			expErr:    ErrUnreachablePosition,
+		},
+		{
+			name:      "Player skipped",
+			gameState: MultiplayerState{Board: [][]SquareState{{x, d, 0}, {0, 0, 0}, {0, 0, 0}}},
+			expErr:    ErrTurnImbalance,
+		},
+		{
+			name:      "Second player first",
+			gameState: MultiplayerState{Board: [][]SquareState{{o, 0, 0}, {0, 0, 0}, {0, 0, 0}}},
+			expErr:    ErrTurnImbalance,
+		},
+		{
+			name:      "Symbol of no player",
+			gameState: MultiplayerState{Board: [][]SquareState{{x, 'Z', 0}, {0, 0, 0}, {0, 0, 0}}},
+			expErr:    ErrInvalidSymbol,
+		},
+		{
+			name:      "Same symbol twice",
+			gameState: MultiplayerState{Players: []SquareState{x, o, x}},
+			expErr:    ErrInvalidPlayer,
+		},
+		{
+			name:      "One player",
+			gameState: MultiplayerState{Players: []SquareState{x}},
+			expErr:    ErrInvalidPlayerCount,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.gameState.initialize()
+			assert.ErrorIs(t, err, tt.expErr)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.expTurn, tt.gameState.Turn)
+			assert.Equal(t, rune(tt.expNextPlayer), tt.gameState.playersTurn())
+		})
+	}
+}
+
+func TestMultiplayer_GetGameResult(t *testing.T) {
+	m := makeMultiplayerState(5, 3, map[SquareState][][2]int{
+		SquareStateCross:  {{0, 0}, {4, 0}},
+		SquareStateNaught: {{0, 4}, {4, 4}},
+		SquareStateDelta:  {{1, 1}, {2, 2}},
+	})
+	result, _ := m.getGameResult()
+	assert.Equal(t, ResultNone, result)
+
+	assert.NoError(t, m.occupyPosition(0, 1))
+	assert.NoError(t, m.occupyPosition(1, 4))
+	assert.NoError(t, m.occupyPosition(3, 3))
+	result, row := m.getGameResult()
+	assert.Equal(t, ResultNInARow, result)
+	assert.Equal(t, rune(SquareStateDelta), linePlayer(row))
+}
+
+func TestMultiplayerEngine_ComputeMove(t *testing.T) {
+	tests := []struct {
+		name      string
+		gameState MultiplayerState
+		expMove   Move
+	}{
+		{
+			name: "Win",
+			gameState: makeMultiplayerState(5, 3, map[SquareState][][2]int{
+				SquareStateCross:  {{0, 0}, {1, 0}},
+				SquareStateNaught: {{0, 4}, {3, 2}},
+				SquareStateDelta:  {{4, 4}, {1, 2}},
+			}),
+			expMove: Move{X: 2, Y: 0},
+		},
+		{
+			name: "Block the next player",
+			gameState: makeMultiplayerState(5, 3, map[SquareState][][2]int{
+				SquareStateCross:  {{0, 0}, {3, 0}},
+				SquareStateNaught: {{0, 4}, {1, 4}},
+				SquareStateDelta:  {{4, 1}, {2, 2}},
+			}),
+			expMove: Move{X: 2, Y: 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &multiplayerEngine{budget: 200 * time.Millisecond}
+			move, _, err := e.ComputeMove(context.Background(), tt.gameState)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expMove, move)
+		})
+	}
+}
+
+func TestMultiplayerStateHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+		expResult     Result
+		expWinner     rune
+		expNextPlayer rune
+		expPlayers    []SquareState
+	}{
+		{
+			name:          "Defaults",
+			body:          `{"variant": "multiplayer", "timeBudget": 50}`,
+			expStatusCode: http.StatusOK,
+			expNextPlayer: rune(SquareStateNaught),
+			expPlayers:    []SquareState{SquareStateCross, SquareStateNaught, SquareStateDelta},
+		},
+		{
+			name: "Computer completes a line",
+			body: `{"variant": "multiplayer", "players": [65, 66, 67, 68], "winLength": 3, ` +
+				`"board": [[65,65,0,0],[66,66,0,0],[67,67,0,0],[68,68,0,0]]}`,
+			expStatusCode: http.StatusOK,
+			expResult:     ResultNInARow,
+			expWinner:     'A',
+			expNextPlayer: 'B',
+			expPlayers:    []SquareState{'A', 'B', 'C', 'D'},
+		},
+		{
+			name:          "Human to move",
+			body:          `{"variant": "multiplayer", "humanPlayer": 88}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Human not playing",
+			body:          `{"variant": "multiplayer", "humanPlayer": 65}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Too many players",
+			body:          `{"variant": "multiplayer", "players": [65, 66, 67, 68, 69, 70, 71, 72, 73]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			TicTacToeStateHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+			if tt.expStatusCode != http.StatusOK {
+				return
+			}
+
+			resp := MultiplayerStateResponse{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expResult, resp.Result)
+			assert.Equal(t, tt.expWinner, resp.Winner)
+			assert.Equal(t, tt.expNextPlayer, resp.NextPlayer)
+			assert.Equal(t, tt.expPlayers, resp.Players)
+		})
+	}
+}
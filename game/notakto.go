@@ -0,0 +1,247 @@
+package game
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+)
+
+// notaktoSize is the length of each side of a Notakto board.
+const notaktoSize = 3
+
+// Number of boards in a game of Notakto when the request does not give any,
+// and the most it may give.
+const (
+	defaultNotaktoBoards = 3
+	maxNotaktoBoards     = 9
+)
+
+// notaktoLines holds the lines of a Notakto board as masks, in which square
+// x, y is bit x + 3y, so that each octal digit is a row.
+var notaktoLines = [...]uint16{0o007, 0o070, 0o700, 0o111, 0o222, 0o444, 0o421, 0o124}
+
+// NotaktoState is a game of Notakto: several 3x3 boards on which both players
+// place crosses. A board is dead once it holds a line of three, after which
+// nobody may play on it, and the player who kills the last board loses.
+// FirstPlayer and HumanPlayer name the players, who both play crosses, as in
+// the other variants.
+type NotaktoState struct {
+	Boards      [][][]SquareState `json:"boards"`
+	BoardCount  int               `json:"boardCount,omitempty"`
+	Variant     Variant           `json:"variant"`
+	FirstPlayer SquareState       `json:"firstPlayer,omitempty"`
+	HumanPlayer SquareState       `json:"humanPlayer,omitempty"`
+	Turn        int               `json:"-"`
+}
+
+// NotaktoStateResponse describes the boards after the computer's move, which
+// of them are dead and, once they all are, the player who lost.
+type NotaktoStateResponse struct {
+	Boards     [][][]SquareState `json:"boards"`
+	Dead       []bool            `json:"dead"`
+	Result     Result            `json:"result,omitempty"`
+	Loser      rune              `json:"loser,omitempty"`
+	Turn       int               `json:"turn"`
+	NextPlayer rune              `json:"nextPlayer"`
+}
+
+// notaktoStateHandler serves game state requests for the Notakto variant,
+// whose body b holds a NotaktoState, responding with a NotaktoStateResponse
+// after the computer's move.
+func notaktoStateHandler(w http.ResponseWriter, r *http.Request, b []byte) {
+	req := &NotaktoState{}
+	err := json.Unmarshal(b, req)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "could not interpret request", err)
+		return
+	}
+
+	err = req.initialize()
+	if err != nil {
+		writeHTTPError(w, errorStatus(err), "invalid game settings", err)
+		return
+	}
+
+	result := req.getGameResult()
+	if result == ResultNone && SquareState(req.playersTurn()) == req.HumanPlayer {
+		writeHTTPError(w, errorStatus(ErrNotComputersTurn), "invalid game state", ErrNotComputersTurn)
+		return
+	}
+	if result == ResultNone {
+		engine := &notaktoEngine{}
+		move, _, err := engine.ComputeMove(r.Context(), *req)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to compute move", err)
+			return
+		}
+		err = req.occupyPosition(move.X, move.Y, move.Z)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to set board", err)
+			return
+		}
+	}
+
+	result = req.getGameResult()
+	resp := NotaktoStateResponse{
+		Boards:     req.Boards,
+		Dead:       make([]bool, len(req.Boards)),
+		Result:     result,
+		Turn:       req.Turn,
+		NextPlayer: req.playersTurn(),
+	}
+	for z, board := range req.Boards {
+		resp.Dead[z] = isDeadNotaktoBoard(board)
+	}
+	if result == ResultMisereLoss {
+		resp.Loser = rune(opponent(SquareState(req.playersTurn())))
+	}
+
+	b, err = json.Marshal(resp)
+	if err != nil {
+		writeHTTPError(w, http.StatusInternalServerError, "failed to marshal response", err)
+		return
+	}
+
+	_, err = w.Write(b)
+	if err != nil {
+		log.Print(err)
+	}
+}
+
+// initialize prepares a Notakto state received in a request, creating
+// BoardCount empty boards when no boards were given, and checking that the
+// boards are 3x3 and hold nothing but crosses.
+func (n *NotaktoState) initialize() error {
+	if len(n.Boards) == 0 {
+		if n.BoardCount == 0 {
+			n.BoardCount = defaultNotaktoBoards
+		}
+		if n.BoardCount < 1 || n.BoardCount > maxNotaktoBoards {
+			return ErrInvalidBoardCount
+		}
+		n.Boards = make([][][]SquareState, n.BoardCount)
+		for z := range n.Boards {
+			n.Boards[z] = makeBoard(notaktoSize)
+		}
+	}
+	if n.BoardCount != 0 && n.BoardCount != len(n.Boards) || len(n.Boards) > maxNotaktoBoards {
+		return ErrInvalidBoardCount
+	}
+	n.BoardCount = len(n.Boards)
+	if !isPlayer(n.FirstPlayer) && n.FirstPlayer != SquareStateEmpty ||
+		!isPlayer(n.HumanPlayer) && n.HumanPlayer != SquareStateEmpty {
+		return ErrInvalidPlayer
+	}
+
+	n.Turn = 1
+	for _, board := range n.Boards {
+		if len(board) != notaktoSize {
+			return ErrInvalidBoardSize
+		}
+		for _, row := range board {
+			if len(row) != notaktoSize {
+				return ErrInvalidBoardSize
+			}
+			for _, square := range row {
+				switch square {
+				case SquareStateEmpty:
+				case SquareStateCross:
+					n.Turn++
+				default:
+					return ErrInvalidSymbol
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (n *NotaktoState) playersTurn() rune {
+	first := opener(n.FirstPlayer)
+	if n.Turn%2 == 1 {
+		return rune(first)
+	}
+
+	return rune(opponent(first))
+}
+
+// occupyPosition places a cross on square x, y of board z.
+func (n *NotaktoState) occupyPosition(x, y, z int) error {
+	if z < 0 || z >= len(n.Boards) || x < 0 || x >= notaktoSize || y < 0 || y >= notaktoSize {
+		return ErrOutOfBounds
+	}
+	board := n.Boards[z]
+	if board[y][x] != SquareStateEmpty {
+		return ErrOccupied
+	}
+	if isDeadNotaktoBoard(board) {
+		return ErrDeadBoard
+	}
+
+	board[y][x] = SquareStateCross
+	n.Turn++
+
+	return nil
+}
+
+// getGameResult returns ResultMisereLoss once every board is dead, the player
+// who killed the last one having lost, and ResultNone before then.
+func (n *NotaktoState) getGameResult() Result {
+	for _, board := range n.Boards {
+		if !isDeadNotaktoBoard(board) {
+			return ResultNone
+		}
+	}
+
+	return ResultMisereLoss
+}
+
+// notaktoMask returns the mask of the crosses on a board.
+func notaktoMask(board [][]SquareState) uint16 {
+	var mask uint16
+	for y, row := range board {
+		for x, square := range row {
+			if square == SquareStateCross {
+				mask |= 1 << uint(x+y*notaktoSize)
+			}
+		}
+	}
+
+	return mask
+}
+
+func isDeadNotaktoMask(mask uint16) bool {
+	for _, line := range notaktoLines {
+		if mask&line == line {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isDeadNotaktoBoard(board [][]SquareState) bool {
+	return isDeadNotaktoMask(notaktoMask(board))
+}
+
+// canonicalNotaktoMask returns the smallest mask of any rotation or
+// reflection of the board with the given mask.
+func canonicalNotaktoMask(mask uint16) uint16 {
+	var canonical uint16
+	for s := 0; s < symmetries; s++ {
+		var m uint16
+		for i := 0; i < notaktoSize*notaktoSize; i++ {
+			if mask&(1<<uint(i)) == 0 {
+				continue
+			}
+			tx, ty := transformSquare(s, i%notaktoSize, i/notaktoSize, notaktoSize)
+			m |= 1 << uint(tx+ty*notaktoSize)
+		}
+		if s == 0 || m < canonical {
+			canonical = m
+		}
+	}
+
+	return canonical
+}
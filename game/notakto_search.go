@@ -0,0 +1,175 @@
+package game
+
+import "context"
+
+// notaktoValue is an element a^A b^B c^C d^D of the misère quotient of
+// Notakto found by Plambeck and Whitehead in "The Secrets of Notakto", the
+// commutative monoid generated by a, b, c and d subject to
+//
+//	a² = 1, b³ = b, b²c = c, c³ = ac², b²d = d, cd = ad, d² = c²
+//
+// Every board has a value in the quotient, the value of several boards is the
+// product of theirs, and the player to move loses exactly when the value of
+// the live boards is a, b², bc or c².
+type notaktoValue struct {
+	a, b, c, d int
+}
+
+// notaktoBoardValues holds the value of every live board that is the
+// smallest of its rotations and reflections, keyed by its mask.
+var notaktoBoardValues = makeNotaktoBoardValues(map[uint16]string{
+	0o000: "c", 0o001: "1", 0o002: "1", 0o003: "d",
+	0o005: "b", 0o012: "a", 0o013: "b", 0o014: "b",
+	0o015: "a", 0o016: "ad", 0o020: "cc", 0o021: "b",
+	0o022: "b", 0o023: "ab", 0o025: "a", 0o032: "ab",
+	0o033: "a", 0o034: "a", 0o035: "b", 0o036: "b",
+	0o050: "a", 0o051: "ad", 0o052: "b", 0o053: "a",
+	0o055: "b", 0o104: "a", 0o105: "ab", 0o106: "ad",
+	0o116: "ab", 0o141: "a", 0o142: "1", 0o143: "b",
+	0o145: "b", 0o146: "a", 0o152: "ab", 0o154: "a",
+	0o156: "b", 0o161: "b", 0o162: "b", 0o163: "a",
+	0o252: "a", 0o253: "b", 0o255: "a", 0o345: "a",
+	0o356: "a", 0o505: "a",
+})
+
+// makeNotaktoBoardValues parses the values of the boards, each written as a
+// word in the generators such as "ab", or "1" for the identity.
+func makeNotaktoBoardValues(words map[uint16]string) map[uint16]notaktoValue {
+	values := make(map[uint16]notaktoValue, len(words))
+	for mask, word := range words {
+		var v notaktoValue
+		for _, g := range word {
+			switch g {
+			case 'a':
+				v.a++
+			case 'b':
+				v.b++
+			case 'c':
+				v.c++
+			case 'd':
+				v.d++
+			}
+		}
+		values[mask] = v.reduce()
+	}
+
+	return values
+}
+
+// times returns the product of two values.
+func (v notaktoValue) times(w notaktoValue) notaktoValue {
+	return notaktoValue{a: v.a + w.a, b: v.b + w.b, c: v.c + w.c, d: v.d + w.d}.reduce()
+}
+
+// reduce applies the relations of the quotient until none applies, which
+// leaves one of its 18 elements.
+func (v notaktoValue) reduce() notaktoValue {
+	for {
+		switch {
+		case v.a >= 2:
+			v.a -= 2
+		case v.b >= 3:
+			v.b -= 2
+		case v.b >= 2 && (v.c >= 1 || v.d >= 1):
+			v.b -= 2
+		case v.c >= 3:
+			v.c, v.a = v.c-1, v.a+1
+		case v.c >= 1 && v.d >= 1:
+			v.c, v.a = v.c-1, v.a+1
+		case v.d >= 2:
+			v.d, v.c = v.d-2, v.c+2
+		default:
+			return v
+		}
+	}
+}
+
+// isLoss reports whether the player to move loses the boards of value v.
+func (v notaktoValue) isLoss() bool {
+	switch v {
+	case notaktoValue{a: 1}, notaktoValue{b: 2}, notaktoValue{b: 1, c: 1}, notaktoValue{c: 2}:
+		return true
+	}
+
+	return false
+}
+
+// notaktoMaskValue returns the value of the board with the given mask, the
+// identity for a dead board, which is out of play.
+func notaktoMaskValue(mask uint16) notaktoValue {
+	if isDeadNotaktoMask(mask) {
+		return notaktoValue{}
+	}
+
+	return notaktoBoardValues[canonicalNotaktoMask(mask)]
+}
+
+// value returns the value of all the boards.
+func (n *NotaktoState) value() notaktoValue {
+	var v notaktoValue
+	for _, board := range n.Boards {
+		v = v.times(notaktoMaskValue(notaktoMask(board)))
+	}
+
+	return v
+}
+
+// notaktoEngine plays perfect Notakto by the values of the boards: it moves
+// to boards whose value loses for its opponent whenever it can. When every
+// move loses it avoids killing the last board for as long as it can, in the
+// hope that its opponent goes wrong.
+type notaktoEngine struct{}
+
+func (e *notaktoEngine) ComputeMove(ctx context.Context, n NotaktoState) (Move, Evaluation, error) {
+	masks := make([]uint16, len(n.Boards))
+	for z, board := range n.Boards {
+		masks[z] = notaktoMask(board)
+	}
+
+	var fallback *Move
+	for z, mask := range masks {
+		if isDeadNotaktoMask(mask) {
+			continue
+		}
+		// The value of the other boards, which the move leaves alone.
+		rest := notaktoValue{}
+		for i, other := range masks {
+			if i != z {
+				rest = rest.times(notaktoMaskValue(other))
+			}
+		}
+		for i := 0; i < notaktoSize*notaktoSize; i++ {
+			if mask&(1<<uint(i)) != 0 {
+				continue
+			}
+			move := Move{X: i % notaktoSize, Y: i / notaktoSize, Z: z}
+			after := mask | 1<<uint(i)
+			if rest.times(notaktoMaskValue(after)).isLoss() {
+				return move, 1, nil
+			}
+			if fallback == nil || killsLastNotaktoBoard(masks, *fallback) && !killsLastNotaktoBoard(masks, move) {
+				fallback = &move
+			}
+		}
+	}
+	if fallback == nil {
+		return Move{}, 0, ErrNoMoves
+	}
+
+	return *fallback, -1, nil
+}
+
+// killsLastNotaktoBoard reports whether move kills the last live board of
+// those with the given masks.
+func killsLastNotaktoBoard(masks []uint16, move Move) bool {
+	if !isDeadNotaktoMask(masks[move.Z] | 1<<uint(move.X+move.Y*notaktoSize)) {
+		return false
+	}
+	for z, mask := range masks {
+		if z != move.Z && !isDeadNotaktoMask(mask) {
+			return false
+		}
+	}
+
+	return true
+}
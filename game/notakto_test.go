@@ -0,0 +1,285 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// makeNotaktoState returns count empty boards with crosses on the given
+// squares, each given as x, y, z.
+func makeNotaktoState(count int, crosses ...[3]int) NotaktoState {
+	n := NotaktoState{Boards: make([][][]SquareState, count), Turn: 1}
+	for z := range n.Boards {
+		n.Boards[z] = makeBoard(notaktoSize)
+	}
+	for _, s := range crosses {
+		n.Boards[s[2]][s[1]][s[0]] = SquareStateCross
+		n.Turn++
+	}
+
+	return n
+}
+
+// notaktoLoses searches the boards with the given canonical masks to the end
+// of the game, reporting whether the player to move loses.
+func notaktoLoses(masks []uint16, memo map[string]bool) bool {
+	if len(masks) == 0 {
+		// The opponent killed the last board.
+		return false
+	}
+	key := ""
+	for _, mask := range masks {
+		key += string(rune(mask)) + ","
+	}
+	if loses, ok := memo[key]; ok {
+		return loses
+	}
+
+	loses := true
+	for z, mask := range masks {
+		for i := 0; i < notaktoSize*notaktoSize && loses; i++ {
+			if mask&(1<<uint(i)) != 0 {
+				continue
+			}
+			next := append([]uint16{}, masks[:z]...)
+			next = append(next, masks[z+1:]...)
+			if after := mask | 1<<uint(i); !isDeadNotaktoMask(after) {
+				next = append(next, canonicalNotaktoMask(after))
+				sort.Slice(next, func(i, j int) bool { return next[i] < next[j] })
+			}
+			if notaktoLoses(next, memo) {
+				loses = false
+			}
+		}
+	}
+	memo[key] = loses
+
+	return loses
+}
+
+func TestNotaktoBoardValues(t *testing.T) {
+	live := make(map[uint16]bool)
+	for mask := uint16(0); mask < 1<<9; mask++ {
+		if !isDeadNotaktoMask(mask) {
+			live[canonicalNotaktoMask(mask)] = true
+		}
+	}
+	assert.Len(t, notaktoBoardValues, len(live))
+	for mask := range live {
+		assert.Contains(t, notaktoBoardValues, mask)
+	}
+
+	// The quotient is closed under multiplication with 18 elements.
+	elements := map[notaktoValue]bool{{}: true}
+	for _, g := range []notaktoValue{{a: 1}, {b: 1}, {c: 1}, {d: 1}} {
+		for v := range elements {
+			elements[v.times(g)] = true
+		}
+	}
+	for grown := true; grown; {
+		grown = false
+		for v := range elements {
+			for w := range elements {
+				if p := v.times(w); !elements[p] {
+					elements[p] = true
+					grown = true
+				}
+			}
+		}
+	}
+	assert.Len(t, elements, 18)
+}
+
+func TestNotaktoValues_MatchSearch(t *testing.T) {
+	var classes []uint16
+	for mask := range notaktoBoardValues {
+		classes = append(classes, mask)
+	}
+	sort.Slice(classes, func(i, j int) bool { return classes[i] < classes[j] })
+
+	memo := make(map[string]bool)
+	for i, a := range classes {
+		for j, b := range classes[i:] {
+			masks := []uint16{a, b}
+			v := notaktoBoardValues[a].times(notaktoBoardValues[b])
+			assert.Equal(t, notaktoLoses(masks, memo), v.isLoss(), "boards %o", masks)
+			for _, c := range classes[i+j:] {
+				masks := []uint16{a, b, c}
+				assert.Equal(t, notaktoLoses(masks, memo), v.times(notaktoBoardValues[c]).isLoss(), "boards %o", masks)
+			}
+		}
+	}
+}
+
+func TestNotakto_OccupyPosition(t *testing.T) {
+	n := makeNotaktoState(2, [3]int{0, 0, 1}, [3]int{1, 0, 1}, [3]int{2, 0, 1})
+	assert.ErrorIs(t, n.occupyPosition(0, 1, 1), ErrDeadBoard)
+	assert.ErrorIs(t, n.occupyPosition(0, 0, 1), ErrOccupied)
+	assert.ErrorIs(t, n.occupyPosition(0, 0, 2), ErrOutOfBounds)
+	assert.NoError(t, n.occupyPosition(0, 0, 0))
+	assert.Equal(t, 5, n.Turn)
+	assert.Equal(t, SquareStateCross, n.Boards[0][0][0])
+}
+
+func TestNotakto_GetGameResult(t *testing.T) {
+	n := makeNotaktoState(2, [3]int{0, 0, 1}, [3]int{1, 0, 1}, [3]int{2, 0, 1})
+	assert.Equal(t, ResultNone, n.getGameResult())
+	n.Boards[0][1][0], n.Boards[0][1][1], n.Boards[0][1][2] = SquareStateCross, SquareStateCross, SquareStateCross
+	assert.Equal(t, ResultMisereLoss, n.getGameResult())
+}
+
+func TestNotaktoEngine_ComputeMove(t *testing.T) {
+	tests := []struct {
+		name      string
+		gameState NotaktoState
+		expMove   *Move
+		expEval   Evaluation
+	}{
+		{
+			name:      "One board",
+			gameState: makeNotaktoState(1),
+			expMove:   &Move{X: 1, Y: 1},
+			expEval:   1,
+		},
+		{
+			name:      "Two boards",
+			gameState: makeNotaktoState(2),
+			expEval:   -1,
+		},
+		{
+			name:      "Centre taken",
+			gameState: makeNotaktoState(1, [3]int{1, 1, 0}),
+			expEval:   -1,
+		},
+		{
+			name:      "Winning reply",
+			gameState: makeNotaktoState(2, [3]int{1, 1, 0}, [3]int{1, 1, 1}, [3]int{0, 0, 1}),
+			expEval:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &notaktoEngine{}
+			move, eval, err := e.ComputeMove(context.Background(), tt.gameState)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expEval, eval)
+			if tt.expMove != nil {
+				assert.Equal(t, *tt.expMove, move)
+			}
+			assert.NoError(t, tt.gameState.occupyPosition(move.X, move.Y, move.Z))
+			assert.Equal(t, ResultNone, tt.gameState.getGameResult())
+		})
+	}
+}
+
+func TestNotaktoEngine_AgainstRandom(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		rng := rand.New(rand.NewSource(seed))
+		n := makeNotaktoState(3)
+		e := &notaktoEngine{}
+		for n.getGameResult() == ResultNone {
+			var move Move
+			if n.playersTurn() == rune(SquareStateCross) {
+				var err error
+				move, _, err = e.ComputeMove(context.Background(), n)
+				assert.NoError(t, err)
+			} else {
+				for {
+					move = Move{X: rng.Intn(notaktoSize), Y: rng.Intn(notaktoSize), Z: rng.Intn(len(n.Boards))}
+					if n.Boards[move.Z][move.Y][move.X] == SquareStateEmpty && !isDeadNotaktoBoard(n.Boards[move.Z]) {
+						break
+					}
+				}
+			}
+			assert.NoError(t, n.occupyPosition(move.X, move.Y, move.Z))
+		}
+
+		// Three boards are a first player win, so naughts killed the last
+		// board and crosses are to move.
+		assert.Equal(t, rune(SquareStateCross), n.playersTurn(), "seed %d", seed)
+	}
+}
+
+func TestNotaktoStateHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+		expBoards     int
+		expTurn       int
+		expResult     Result
+		expLoser      rune
+	}{
+		{
+			name:          "Default boards",
+			body:          `{"variant": "notakto"}`,
+			expStatusCode: http.StatusOK,
+			expBoards:     3,
+			expTurn:       2,
+		},
+		{
+			name:          "Board count",
+			body:          `{"variant": "notakto", "boardCount": 5}`,
+			expStatusCode: http.StatusOK,
+			expBoards:     5,
+			expTurn:       2,
+		},
+		{
+			name:          "Game over",
+			body:          `{"variant": "notakto", "boards": [[[88,88,88],[0,0,0],[0,0,0]]]}`,
+			expStatusCode: http.StatusOK,
+			expBoards:     1,
+			expTurn:       4,
+			expResult:     ResultMisereLoss,
+			expLoser:      rune(SquareStateCross),
+		},
+		{
+			name:          "Naughts",
+			body:          `{"variant": "notakto", "boards": [[[48,0,0],[0,0,0],[0,0,0]]]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Not 3x3",
+			body:          `{"variant": "notakto", "boards": [[[0,0],[0,0]]]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Too many boards",
+			body:          `{"variant": "notakto", "boardCount": 10}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Human to move",
+			body:          `{"variant": "notakto", "humanPlayer": 88}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			TicTacToeStateHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+			if tt.expStatusCode != http.StatusOK {
+				return
+			}
+
+			resp := NotaktoStateResponse{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Len(t, resp.Boards, tt.expBoards)
+			assert.Len(t, resp.Dead, tt.expBoards)
+			assert.Equal(t, tt.expTurn, resp.Turn)
+			assert.Equal(t, tt.expResult, resp.Result)
+			assert.Equal(t, tt.expLoser, resp.Loser)
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package game
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+)
+
+// numericalSize is the length of each side of a numerical board.
+const numericalSize = 3
+
+// numericalSquares is the number of squares on a numerical board.
+const numericalSquares = numericalSize * numericalSize
+
+// numericalTarget is the sum of a winning line.
+const numericalTarget = 15
+
+// numericalLines holds the lines of a numerical board, each as the indices
+// x + 3y of its squares.
+var numericalLines = [...][numericalSize]int{
+	{0, 1, 2}, {3, 4, 5}, {6, 7, 8},
+	{0, 3, 6}, {1, 4, 7}, {2, 5, 8},
+	{0, 4, 8}, {2, 4, 6},
+}
+
+// Parity names a player of numerical tic-tac-toe by the numbers they place.
+type Parity string
+
+const (
+	// ParityOdd places 1, 3, 5, 7 and 9, and always moves first.
+	ParityOdd Parity = "odd"
+	// ParityEven places 2, 4, 6 and 8.
+	ParityEven Parity = "even"
+)
+
+// NumericalState is a game of numerical tic-tac-toe: a 3x3 board on which
+// the players place the numbers 1 to 9, each at most once, with zero for an
+// empty square. The odd player opens the game placing odd numbers, the even
+// player replies placing even numbers, and whoever completes a line of three
+// numbers adding up to 15 wins, whatever numbers it holds. The computer plays
+// against HumanPlayer when it is set, or otherwise plays whichever side's
+// turn it is.
+type NumericalState struct {
+	Board       [][]int `json:"board"`
+	Variant     Variant `json:"variant"`
+	HumanPlayer Parity  `json:"humanPlayer,omitempty"`
+	Turn        int     `json:"-"`
+}
+
+// NumericalStateResponse describes the board after the computer's move and,
+// once a line adds up to 15, the player who completed it.
+type NumericalStateResponse struct {
+	Board      [][]int `json:"board"`
+	Result     Result  `json:"result,omitempty"`
+	WinningRow [][]int `json:"winningRow,omitempty"`
+	Winner     Parity  `json:"winner,omitempty"`
+	Turn       int     `json:"turn"`
+	NextPlayer Parity  `json:"nextPlayer"`
+}
+
+// numericalStateHandler serves game state requests for the numerical
+// variant, whose body b holds a NumericalState, responding with a
+// NumericalStateResponse after the computer's move.
+func numericalStateHandler(w http.ResponseWriter, r *http.Request, b []byte) {
+	req := &NumericalState{}
+	err := json.Unmarshal(b, req)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "could not interpret request", err)
+		return
+	}
+
+	err = req.initialize()
+	if err != nil {
+		writeHTTPError(w, errorStatus(err), "invalid game settings", err)
+		return
+	}
+
+	result, _ := req.getGameResult()
+	if result == ResultNone && req.playersTurn() == req.HumanPlayer {
+		writeHTTPError(w, errorStatus(ErrNotComputersTurn), "invalid game state", ErrNotComputersTurn)
+		return
+	}
+	if result == ResultNone {
+		engine := &numericalEngine{}
+		move, _, err := engine.ComputeMove(r.Context(), *req)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to compute move", err)
+			return
+		}
+		err = req.occupyPosition(move.X, move.Y, move.Number)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to set board", err)
+			return
+		}
+	}
+
+	result, winningRow := req.getGameResult()
+	resp := NumericalStateResponse{
+		Board:      req.Board,
+		Result:     result,
+		WinningRow: winningRow,
+		Turn:       req.Turn,
+		NextPlayer: req.playersTurn(),
+	}
+	if result == ResultNInARow {
+		// The line was completed by the player who moved last.
+		resp.Winner = req.opponent(req.playersTurn())
+	}
+
+	b, err = json.Marshal(resp)
+	if err != nil {
+		writeHTTPError(w, http.StatusInternalServerError, "failed to marshal response", err)
+		return
+	}
+
+	_, err = w.Write(b)
+	if err != nil {
+		log.Print(err)
+	}
+}
+
+func makeNumericalBoard() [][]int {
+	board := make([][]int, numericalSize)
+	for y := range board {
+		board[y] = make([]int, numericalSize)
+	}
+
+	return board
+}
+
+// initialize prepares a numerical state received in a request, creating an
+// empty board when no board was given, and checking that the board is 3x3,
+// holds each number from 1 to 9 at most once, and that the players have taken
+// turns.
+func (n *NumericalState) initialize() error {
+	if len(n.Board) == 0 {
+		n.Board = makeNumericalBoard()
+	}
+	if len(n.Board) != numericalSize {
+		return ErrInvalidBoardSize
+	}
+	if n.HumanPlayer != "" && n.HumanPlayer != ParityOdd && n.HumanPlayer != ParityEven {
+		return ErrInvalidPlayer
+	}
+
+	used := make(map[int]bool)
+	odd, even := 0, 0
+	for _, row := range n.Board {
+		if len(row) != numericalSize {
+			return ErrInvalidBoardSize
+		}
+		for _, number := range row {
+			switch {
+			case number == 0:
+				continue
+			case number < 1 || number > numericalSquares:
+				return ErrInvalidNumber
+			case used[number]:
+				return ErrNumberUsed
+			case number%2 == 1:
+				odd++
+			default:
+				even++
+			}
+			used[number] = true
+		}
+	}
+	if odd != even && odd != even+1 {
+		return ErrTurnImbalance
+	}
+	n.Turn = odd + even + 1
+
+	return nil
+}
+
+// playersTurn returns the player whose turn it is.
+func (n *NumericalState) playersTurn() Parity {
+	if n.Turn%2 == 1 {
+		return ParityOdd
+	}
+
+	return ParityEven
+}
+
+// opponent returns the other player.
+func (n *NumericalState) opponent(player Parity) Parity {
+	if player == ParityOdd {
+		return ParityEven
+	}
+
+	return ParityOdd
+}
+
+// occupyPosition places number on square x, y for the player whose turn it
+// is, provided it is of their parity and has not been placed before.
+func (n *NumericalState) occupyPosition(x, y, number int) error {
+	if x < 0 || x >= numericalSize || y < 0 || y >= numericalSize {
+		return ErrOutOfBounds
+	}
+	if n.Board[y][x] != 0 {
+		return ErrOccupied
+	}
+	if number < 1 || number > numericalSquares || (number%2 == 1) != (n.playersTurn() == ParityOdd) {
+		return ErrInvalidNumber
+	}
+	for _, row := range n.Board {
+		for _, used := range row {
+			if used == number {
+				return ErrNumberUsed
+			}
+		}
+	}
+
+	n.Board[y][x] = number
+	n.Turn++
+
+	return nil
+}
+
+// getGameResult calculates the current state of the game returning the result
+// and the line that concluded the game if a line of three numbers adds up to
+// 15, nil otherwise.
+func (n *NumericalState) getGameResult() (Result, [][]int) {
+	for _, line := range numericalLines {
+		sum, full := 0, true
+		for _, i := range line {
+			number := n.Board[i/numericalSize][i%numericalSize]
+			full = full && number != 0
+			sum += number
+		}
+		if !full || sum != numericalTarget {
+			continue
+		}
+
+		winningRow := makeNumericalBoard()
+		for _, i := range line {
+			winningRow[i/numericalSize][i%numericalSize] = n.Board[i/numericalSize][i%numericalSize]
+		}
+		return ResultNInARow, winningRow
+	}
+
+	if n.Turn > numericalSquares {
+		return ResultStalemate, nil
+	}
+
+	return ResultNone, nil
+}
@@ -0,0 +1,198 @@
+package game
+
+import "context"
+
+// numericalWin is one more than the score of winning numerical tic-tac-toe on
+// the very next move. Wins further off score one less for every ply needed to
+// reach them, as scoreWin does for boards.
+const numericalWin = numericalSquares + 1
+
+// numericalSymmetries maps each square of a numerical board, by its index
+// x + 3y, onto the square it lands on under each rotation and reflection.
+var numericalSymmetries = makeNumericalSymmetries()
+
+func makeNumericalSymmetries() [symmetries][numericalSquares]int {
+	var maps [symmetries][numericalSquares]int
+	for s := range maps {
+		for i := range maps[s] {
+			x, y := transformSquare(s, i%numericalSize, i/numericalSize, numericalSize)
+			maps[s][i] = x + y*numericalSize
+		}
+	}
+
+	return maps
+}
+
+// numericalBoard holds the numbers on a numerical board by square index
+// x + 3y, with zero for an empty square.
+type numericalBoard [numericalSquares]int
+
+// numericalEngine plays perfect numerical tic-tac-toe. The game is small
+// enough to solve from any position, so it searches to the end of the game,
+// preferring quick wins and drawn-out losses.
+type numericalEngine struct{}
+
+func (e *numericalEngine) ComputeMove(ctx context.Context, n NumericalState) (Move, Evaluation, error) {
+	var board numericalBoard
+	for y, row := range n.Board {
+		for x, number := range row {
+			board[x+y*numericalSize] = number
+		}
+	}
+
+	s := &numericalSearch{scores: make(map[uint64]int)}
+	var best *Move
+	bestScore := 0
+	for _, move := range board.moves() {
+		board[move[0]] = move[1]
+		score := s.scoreMove(&board, move[0])
+		board[move[0]] = 0
+		if best == nil || score > bestScore {
+			best = &Move{X: move[0] % numericalSize, Y: move[0] / numericalSize, Number: move[1]}
+			bestScore = score
+		}
+	}
+	if best == nil {
+		return Move{}, 0, ErrNoMoves
+	}
+
+	eval := Evaluation(0)
+	if bestScore > 0 {
+		eval = 1
+	} else if bestScore < 0 {
+		eval = -1
+	}
+
+	return *best, eval, nil
+}
+
+// numericalSearch holds the state of a single search of a numerical board.
+type numericalSearch struct {
+	// scores holds the scores of the positions solved so far, keyed by
+	// numericalBoard.key.
+	scores map[uint64]int
+}
+
+// scoreMove returns the score, from the point of view of the player who made
+// it, of the move that just placed a number on square i.
+func (s *numericalSearch) scoreMove(board *numericalBoard, i int) int {
+	if board.completes(i) {
+		return numericalWin - 1
+	}
+	if board.pieces() == numericalSquares {
+		return 0
+	}
+
+	score := -s.solve(board)
+	if score > 0 {
+		score--
+	} else if score < 0 {
+		score++
+	}
+
+	return score
+}
+
+// solve returns the score of the position for the player whose turn it is,
+// which is still in play.
+func (s *numericalSearch) solve(board *numericalBoard) int {
+	key := board.key()
+	if score, ok := s.scores[key]; ok {
+		return score
+	}
+
+	moves := board.moves()
+	best := -numericalWin
+	// Nothing beats winning on this move, so look for that first.
+	for _, move := range moves {
+		board[move[0]] = move[1]
+		won := board.completes(move[0])
+		board[move[0]] = 0
+		if won {
+			s.scores[key] = numericalWin - 1
+			return numericalWin - 1
+		}
+	}
+	for _, move := range moves {
+		board[move[0]] = move[1]
+		if score := s.scoreMove(board, move[0]); score > best {
+			best = score
+		}
+		board[move[0]] = 0
+	}
+	s.scores[key] = best
+
+	return best
+}
+
+// moves returns the moves open to the player whose turn it is, each as a
+// square index and the number placed on it.
+func (b *numericalBoard) moves() [][2]int {
+	used := 0
+	for _, number := range b {
+		used |= 1 << uint(number)
+	}
+	first := 1
+	if b.pieces()%2 == 1 {
+		first = 2
+	}
+
+	var moves [][2]int
+	for i, number := range b {
+		if number != 0 {
+			continue
+		}
+		for m := first; m <= numericalSquares; m += 2 {
+			if used&(1<<uint(m)) == 0 {
+				moves = append(moves, [2]int{i, m})
+			}
+		}
+	}
+
+	return moves
+}
+
+// pieces returns the number of numbers placed.
+func (b *numericalBoard) pieces() int {
+	count := 0
+	for _, number := range b {
+		if number != 0 {
+			count++
+		}
+	}
+
+	return count
+}
+
+// completes reports whether a line through square i holds three numbers
+// adding up to 15.
+func (b *numericalBoard) completes(i int) bool {
+	for _, line := range numericalLines {
+		if line[0] != i && line[1] != i && line[2] != i {
+			continue
+		}
+		a, c, d := b[line[0]], b[line[1]], b[line[2]]
+		if a != 0 && c != 0 && d != 0 && a+c+d == numericalTarget {
+			return true
+		}
+	}
+
+	return false
+}
+
+// key identifies the position by the smallest of the numbers spelled out by
+// the squares of its rotations and reflections, read as decimal digits.
+func (b *numericalBoard) key() uint64 {
+	var key uint64
+	for s, squares := range numericalSymmetries {
+		var k uint64
+		for i := numericalSquares - 1; i >= 0; i-- {
+			k = k*10 + uint64(b[squares[i]])
+		}
+		if s == 0 || k < key {
+			key = k
+		}
+	}
+
+	return key
+}
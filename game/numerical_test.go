@@ -0,0 +1,269 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumerical_Initialize(t *testing.T) {
+	tests := []struct {
+		name    string
+		board   [][]int
+		expErr  error
+		expTurn int
+	}{
+		{
+			name:    "Empty",
+			expTurn: 1,
+		},
+		{
+			name:    "Even to move",
+			board:   [][]int{{5, 0, 0}, {0, 0, 0}, {0, 0, 0}},
+			expTurn: 2,
+		},
+		{
+			name:   "Number too large",
+			board:  [][]int{{10, 0, 0}, {0, 0, 0}, {0, 0, 0}},
+			expErr: ErrInvalidNumber,
+		},
+		{
+			name:   "Number used twice",
+			board:  [][]int{{5, 2, 5}, {0, 0, 0}, {0, 0, 0}},
+			expErr: ErrNumberUsed,
+		},
+		{
+			name:   "Even moved first",
+			board:  [][]int{{2, 0, 0}, {0, 0, 0}, {0, 0, 0}},
+			expErr: ErrTurnImbalance,
+		},
+		{
+			name:   "Jagged board",
+			board:  [][]int{{0, 0, 0}, {0, 0}, {0, 0, 0}},
+			expErr: ErrInvalidBoardSize,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NumericalState{Board: tt.board, Variant: VariantNumerical}
+			err := n.initialize()
+			assert.ErrorIs(t, err, tt.expErr)
+			if err == nil {
+				assert.Equal(t, tt.expTurn, n.Turn)
+			}
+		})
+	}
+}
+
+func TestNumerical_OccupyPosition(t *testing.T) {
+	tests := []struct {
+		name   string
+		x, y   int
+		number int
+		expErr error
+	}{
+		{
+			name:   "Even number",
+			x:      1,
+			number: 4,
+		},
+		{
+			name:   "Odd number on even's turn",
+			x:      1,
+			number: 3,
+			expErr: ErrInvalidNumber,
+		},
+		{
+			name:   "Number already used",
+			x:      1,
+			number: 2,
+			expErr: ErrNumberUsed,
+		},
+		{
+			name:   "Occupied",
+			number: 4,
+			expErr: ErrOccupied,
+		},
+		{
+			name:   "Off the board",
+			x:      3,
+			number: 4,
+			expErr: ErrOutOfBounds,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NumericalState{Board: [][]int{{5, 0, 0}, {0, 2, 0}, {0, 0, 9}}, Variant: VariantNumerical}
+			assert.NoError(t, n.initialize())
+			err := n.occupyPosition(tt.x, tt.y, tt.number)
+			assert.ErrorIs(t, err, tt.expErr)
+			if err == nil {
+				assert.Equal(t, tt.number, n.Board[tt.y][tt.x])
+				assert.Equal(t, ParityOdd, n.playersTurn())
+			}
+		})
+	}
+}
+
+func TestNumerical_GetGameResult(t *testing.T) {
+	tests := []struct {
+		name    string
+		board   [][]int
+		want    Result
+		wantRow [][]int
+	}{
+		{
+			name:  "Line not adding up",
+			board: [][]int{{1, 2, 3}, {4, 0, 0}, {0, 0, 0}},
+			want:  ResultNone,
+		},
+		{
+			name:    "Row",
+			board:   [][]int{{2, 9, 4}, {1, 0, 0}, {3, 0, 0}},
+			want:    ResultNInARow,
+			wantRow: [][]int{{2, 9, 4}, {0, 0, 0}, {0, 0, 0}},
+		},
+		{
+			name:    "Diagonal of odd and even",
+			board:   [][]int{{2, 0, 1}, {0, 9, 0}, {3, 0, 4}},
+			want:    ResultNInARow,
+			wantRow: [][]int{{2, 0, 0}, {0, 9, 0}, {0, 0, 4}},
+		},
+		{
+			name:  "Full board",
+			board: [][]int{{1, 2, 3}, {4, 5, 7}, {6, 9, 8}},
+			want:  ResultStalemate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NumericalState{Board: tt.board, Variant: VariantNumerical}
+			n.Turn = 1
+			for _, row := range tt.board {
+				for _, number := range row {
+					if number != 0 {
+						n.Turn++
+					}
+				}
+			}
+			got, gotRow := n.getGameResult()
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantRow, gotRow)
+		})
+	}
+}
+
+func TestNumericalEngine_ComputeMove(t *testing.T) {
+	tests := []struct {
+		name    string
+		board   [][]int
+		expMove *Move
+		expEval Evaluation
+	}{
+		{
+			name:    "First player wins",
+			expEval: 1,
+		},
+		{
+			name:    "Win",
+			board:   [][]int{{6, 0, 8}, {0, 3, 0}, {0, 0, 5}},
+			expMove: &Move{X: 1, Y: 0, Number: 1},
+			expEval: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NumericalState{Board: tt.board, Variant: VariantNumerical}
+			assert.NoError(t, n.initialize())
+			e := &numericalEngine{}
+			move, eval, err := e.ComputeMove(context.Background(), n)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expEval, eval)
+			if tt.expMove != nil {
+				assert.Equal(t, *tt.expMove, move)
+			}
+			assert.NoError(t, n.occupyPosition(move.X, move.Y, move.Number))
+		})
+	}
+}
+
+func TestNumericalEngine_PerfectPlay(t *testing.T) {
+	n := NumericalState{Variant: VariantNumerical}
+	assert.NoError(t, n.initialize())
+	e := &numericalEngine{}
+	result := ResultNone
+	for result == ResultNone {
+		move, _, err := e.ComputeMove(context.Background(), n)
+		assert.NoError(t, err)
+		assert.NoError(t, n.occupyPosition(move.X, move.Y, move.Number))
+		result, _ = n.getGameResult()
+	}
+
+	// The odd player, who moves first, wins with perfect play.
+	assert.Equal(t, ResultNInARow, result)
+	assert.Equal(t, ParityEven, n.playersTurn())
+}
+
+func TestNumericalStateHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+		expResult     Result
+		expWinner     Parity
+		expNext       Parity
+	}{
+		{
+			name:          "Computer opens",
+			body:          `{"variant": "numerical"}`,
+			expStatusCode: http.StatusOK,
+			expNext:       ParityEven,
+		},
+		{
+			name:          "Computer completes a line",
+			body:          `{"variant": "numerical", "board": [[6,0,8],[0,3,0],[0,0,5]]}`,
+			expStatusCode: http.StatusOK,
+			expResult:     ResultNInARow,
+			expWinner:     ParityOdd,
+			expNext:       ParityEven,
+		},
+		{
+			name:          "Human to move",
+			body:          `{"variant": "numerical", "humanPlayer": "odd"}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Invalid player",
+			body:          `{"variant": "numerical", "humanPlayer": "prime"}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Number used twice",
+			body:          `{"variant": "numerical", "board": [[1,2,1],[0,0,0],[0,0,0]]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			TicTacToeStateHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+			if tt.expStatusCode != http.StatusOK {
+				return
+			}
+
+			resp := NumericalStateResponse{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expResult, resp.Result)
+			assert.Equal(t, tt.expWinner, resp.Winner)
+			assert.Equal(t, tt.expNext, resp.NextPlayer)
+		})
+	}
+}
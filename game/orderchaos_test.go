@@ -0,0 +1,203 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// makeOrderChaosState returns an empty 6x6 Order and Chaos board with the
+// given symbols placed on the given squares, each given as x, y.
+func makeOrderChaosState(symbols []SquareState, squares ...[2]int) TicTacToeState {
+	t := TicTacToeState{
+		Board:     makeBoard(orderChaosBoardSize),
+		WinLength: orderChaosWinLength,
+		Variant:   VariantOrderChaos,
+		Turn:      1,
+	}
+	for i, s := range squares {
+		t.Board[s[1]][s[0]] = symbols[i]
+		t.Turn++
+	}
+
+	return t
+}
+
+func TestOrderChaos_Winner(t *testing.T) {
+	x, o := SquareStateCross, SquareStateNaught
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		move      Move
+		expResult Result
+		expRole   Role
+	}{
+		{
+			name:      "Order completes a line",
+			gameState: makeOrderChaosState([]SquareState{o, o, o, o}, [2]int{0, 0}, [2]int{1, 0}, [2]int{2, 0}, [2]int{3, 0}),
+			move:      Move{X: 4, Y: 0, Symbol: o},
+			expResult: ResultNInARow,
+			expRole:   RoleOrder,
+		},
+		{
+			name: "Chaos completes a line",
+			gameState: makeOrderChaosState([]SquareState{x, x, x, x, o},
+				[2]int{0, 1}, [2]int{1, 1}, [2]int{2, 1}, [2]int{3, 1}, [2]int{5, 5}),
+			move:      Move{X: 4, Y: 1, Symbol: x},
+			expResult: ResultNInARow,
+			expRole:   RoleOrder,
+		},
+		{
+			name:      "Chaos blocks",
+			gameState: makeOrderChaosState([]SquareState{x, x, x, x, o}, [2]int{0, 1}, [2]int{1, 1}, [2]int{2, 1}, [2]int{3, 1}, [2]int{5, 5}),
+			move:      Move{X: 4, Y: 1, Symbol: o},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, tt.gameState.placeSymbol(tt.move.X, tt.move.Y, tt.move.Symbol))
+			result, row := tt.gameState.getGameResult()
+			assert.Equal(t, tt.expResult, result)
+			assert.Equal(t, tt.expRole, tt.gameState.role(tt.gameState.winner(result, row)))
+		})
+	}
+}
+
+func TestOrderChaos_FullBoard(t *testing.T) {
+	// Columns of alternating pairs leave no five in a row in any direction.
+	gs := TicTacToeState{Board: makeBoard(orderChaosBoardSize), WinLength: orderChaosWinLength, Variant: VariantOrderChaos, Turn: 1}
+	for y := range gs.Board {
+		for x := range gs.Board[y] {
+			gs.Board[y][x] = SquareStateCross
+			if (x/2+y)%2 == 1 {
+				gs.Board[y][x] = SquareStateNaught
+			}
+			gs.Turn++
+		}
+	}
+
+	result, row := gs.getGameResult()
+	assert.Equal(t, ResultStalemate, result)
+	assert.Equal(t, RoleChaos, gs.role(gs.winner(result, row)))
+}
+
+func TestOrderChaos_ComputeMove(t *testing.T) {
+	x, o := SquareStateCross, SquareStateNaught
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		expMove   Move
+	}{
+		{
+			name:      "Order completes five",
+			gameState: makeOrderChaosState([]SquareState{o, o, o, o}, [2]int{0, 0}, [2]int{1, 0}, [2]int{2, 0}, [2]int{3, 0}),
+			expMove:   Move{X: 4, Y: 0, Symbol: o},
+		},
+		{
+			name: "Chaos blocks with the other symbol",
+			gameState: makeOrderChaosState([]SquareState{x, x, x, x, o},
+				[2]int{0, 1}, [2]int{1, 1}, [2]int{2, 1}, [2]int{3, 1}, [2]int{5, 5}),
+			expMove: Move{X: 4, Y: 1, Symbol: o},
+		},
+	}
+	for _, tt := range tests {
+		for _, name := range Engines() {
+			if name == EngineRandom {
+				continue
+			}
+			t.Run(tt.name+"/"+name, func(t *testing.T) {
+				engine, err := NewEngine(name, EngineOptions{Iterations: 20000, Rand: rand.New(rand.NewSource(1))})
+				assert.NoError(t, err)
+				move, _, err := engine.ComputeMove(context.Background(), tt.gameState)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expMove, move)
+			})
+		}
+	}
+}
+
+func TestOrderChaosStateHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		expStatusCode  int
+		expSize        int
+		expWinLength   int
+		expNextRole    Role
+		expWinningRole Role
+	}{
+		{
+			name:          "Defaults",
+			body:          `{"variant": "orderchaos", "timeBudget": 50}`,
+			expStatusCode: http.StatusOK,
+			expSize:       6,
+			expWinLength:  5,
+			expNextRole:   RoleChaos,
+		},
+		{
+			name:          "Human plays Order",
+			body:          `{"variant": "orderchaos", "timeBudget": 50, "humanRole": "order", "board": [[88,0,0,0,0,0],[0,0,0,0,0,0],[0,0,0,0,0,0],[0,0,0,0,0,0],[0,0,0,0,0,0],[0,0,0,0,0,0]]}`,
+			expStatusCode: http.StatusOK,
+			expSize:       6,
+			expWinLength:  5,
+			expNextRole:   RoleOrder,
+		},
+		{
+			name: "Computer completes a line",
+			body: `{"variant": "orderchaos", "board": [[48,48,48,48,0,0],[0,0,0,0,0,0],` +
+				`[0,0,0,0,0,0],[0,0,0,0,0,0],[0,0,0,0,0,0],[0,0,0,0,0,0]]}`,
+			expStatusCode:  http.StatusOK,
+			expSize:        6,
+			expWinLength:   5,
+			expNextRole:    RoleChaos,
+			expWinningRole: RoleOrder,
+		},
+		{
+			name:          "Not the computer's role",
+			body:          `{"variant": "orderchaos", "humanRole": "order"}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Players chosen by symbol",
+			body:          `{"variant": "orderchaos", "humanPlayer": 88}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Invalid role",
+			body:          `{"variant": "orderchaos", "humanRole": "anarchy"}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Role outside Order and Chaos",
+			body:          `{"humanRole": "order"}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			TicTacToeStateHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+			if tt.expStatusCode != http.StatusOK {
+				return
+			}
+
+			resp := TicTacToeStateResponse{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Len(t, resp.Board, tt.expSize)
+			assert.Equal(t, tt.expWinLength, resp.WinLength)
+			assert.Equal(t, tt.expNextRole, resp.NextRole)
+			assert.Equal(t, tt.expWinningRole, resp.WinningRole)
+			assert.Zero(t, resp.NextPlayer)
+			assert.Zero(t, resp.Winner)
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package game
+
+import (
+	"encoding/json"
+	"log"
+	"math/bits"
+	"net/http"
+	"time"
+)
+
+// qubicSize is the length of each edge of the Qubic cube.
+const qubicSize = 4
+
+// qubicSquares is the number of squares in the Qubic cube.
+const qubicSquares = qubicSize * qubicSize * qubicSize
+
+// qubicLines holds the 76 lines of four squares through the cube as
+// bitboards, in which square x, y, z is bit qubicSquare(x, y, z).
+var qubicLines = makeQubicLines()
+
+// qubicLinesThrough holds the lines through each square of the cube.
+var qubicLinesThrough = makeQubicLinesThrough()
+
+// QubicState is a 4x4x4 cube, indexed by layer, row and column, on which the
+// first player to complete a line of four squares wins. Lines run along rows,
+// columns and diagonals within a layer as well as through the layers.
+type QubicState struct {
+	Board       [][][]SquareState `json:"board"`
+	Variant     Variant           `json:"variant"`
+	FirstPlayer SquareState       `json:"firstPlayer,omitempty"`
+	HumanPlayer SquareState       `json:"humanPlayer,omitempty"`
+	TimeBudget  int               `json:"timeBudget,omitempty"` // milliseconds
+	Turn        int               `json:"-"`
+}
+
+type QubicStateResponse struct {
+	Board      [][][]SquareState `json:"board"`
+	Result     Result            `json:"result,omitempty"`
+	WinningRow [][][]SquareState `json:"winningRow,omitempty"`
+	Turn       int               `json:"turn"`
+	NextPlayer rune              `json:"nextPlayer"`
+}
+
+// qubicStateHandler serves game state requests for the Qubic variant, whose
+// body b holds a QubicState, responding with a QubicStateResponse after the
+// computer's move.
+func qubicStateHandler(w http.ResponseWriter, r *http.Request, b []byte) {
+	req := &QubicState{}
+	err := json.Unmarshal(b, req)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "could not interpret request", err)
+		return
+	}
+
+	err = req.initialize()
+	if err != nil {
+		writeHTTPError(w, errorStatus(err), "invalid game settings", err)
+		return
+	}
+	if req.TimeBudget < 0 {
+		writeHTTPError(w, errorStatus(ErrInvalidSearchLimit), "invalid engine", ErrInvalidSearchLimit)
+		return
+	}
+
+	result, _ := req.getGameResult()
+	if result == ResultNone && SquareState(req.playersTurn()) == req.HumanPlayer {
+		writeHTTPError(w, errorStatus(ErrNotComputersTurn), "invalid game state", ErrNotComputersTurn)
+		return
+	}
+	if result == ResultNone {
+		engine := &qubicEngine{budget: time.Duration(req.TimeBudget) * time.Millisecond}
+		move, _, err := engine.ComputeMove(r.Context(), *req)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to compute move", err)
+			return
+		}
+		err = req.occupyPosition(move.X, move.Y, move.Z)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to set board", err)
+			return
+		}
+	}
+
+	result, winningRow := req.getGameResult()
+	resp := QubicStateResponse{
+		Board:      req.Board,
+		Result:     result,
+		WinningRow: winningRow,
+		Turn:       req.Turn,
+		NextPlayer: req.playersTurn(),
+	}
+
+	b, err = json.Marshal(resp)
+	if err != nil {
+		writeHTTPError(w, http.StatusInternalServerError, "failed to marshal response", err)
+		return
+	}
+
+	_, err = w.Write(b)
+	if err != nil {
+		log.Print(err)
+	}
+}
+
+func makeCube() [][][]SquareState {
+	cube := make([][][]SquareState, qubicSize)
+	for z := range cube {
+		cube[z] = makeBoard(qubicSize)
+	}
+
+	return cube
+}
+
+// initialize prepares a Qubic state received in a request, creating an empty
+// cube when no board was given, and checking that the cube is 4x4x4, holds
+// nothing but the players' pieces, that the players have taken turns and that
+// play could have led to it.
+func (q *QubicState) initialize() error {
+	if len(q.Board) == 0 {
+		q.Board = makeCube()
+	}
+	if len(q.Board) != qubicSize {
+		return ErrInvalidBoardSize
+	}
+	for _, layer := range q.Board {
+		if len(layer) != qubicSize {
+			return ErrInvalidBoardSize
+		}
+		for _, row := range layer {
+			if len(row) != qubicSize {
+				return ErrInvalidBoardSize
+			}
+			for _, square := range row {
+				if square != SquareStateEmpty && !isPlayer(square) {
+					return ErrInvalidSymbol
+				}
+			}
+		}
+	}
+	if !isPlayer(q.FirstPlayer) && q.FirstPlayer != SquareStateEmpty ||
+		!isPlayer(q.HumanPlayer) && q.HumanPlayer != SquareStateEmpty {
+		return ErrInvalidPlayer
+	}
+
+	first := opener(q.FirstPlayer)
+	pieces := q.pieces(first)
+	replies := q.pieces(opponent(first))
+	n, m := bits.OnesCount64(pieces), bits.OnesCount64(replies)
+	if n != m && n != m+1 {
+		return ErrTurnImbalance
+	}
+	// Only the player who moved last can have completed a line, with which
+	// the game ended.
+	for _, line := range qubicLines {
+		if pieces&line == line && n != m+1 || replies&line == line && n != m {
+			return ErrUnreachablePosition
+		}
+	}
+	q.Turn = n + m + 1
+
+	return nil
+}
+
+func (q *QubicState) playersTurn() rune {
+	first := opener(q.FirstPlayer)
+	if q.Turn%2 == 1 {
+		return rune(first)
+	}
+
+	return rune(opponent(first))
+}
+
+func (q *QubicState) occupyPosition(x, y, z int) error {
+	if x < 0 || x >= qubicSize || y < 0 || y >= qubicSize || z < 0 || z >= qubicSize {
+		return ErrOutOfBounds
+	}
+	if q.Board[z][y][x] != SquareStateEmpty {
+		return ErrOccupied
+	}
+
+	q.Board[z][y][x] = SquareState(q.playersTurn())
+	q.Turn++
+
+	return nil
+}
+
+// getGameResult calculates the current state of the game returning the result
+// and the line that concluded the game if there is a complete line, nil
+// otherwise.
+func (q *QubicState) getGameResult() (Result, [][][]SquareState) {
+	for _, player := range []SquareState{SquareStateCross, SquareStateNaught} {
+		pieces := q.pieces(player)
+		for _, line := range qubicLines {
+			if pieces&line != line {
+				continue
+			}
+
+			winningRow := makeCube()
+			for m := line; m != 0; m &= m - 1 {
+				x, y, z := qubicCoordinates(bits.TrailingZeros64(m))
+				winningRow[z][y][x] = player
+			}
+			return ResultNInARow, winningRow
+		}
+	}
+
+	if q.Turn > qubicSquares {
+		return ResultStalemate, nil
+	}
+
+	return ResultNone, nil
+}
+
+// pieces returns the bitboard of the squares holding the player's pieces.
+func (q *QubicState) pieces(player SquareState) uint64 {
+	var pieces uint64
+	for z, layer := range q.Board {
+		for y, row := range layer {
+			for x, square := range row {
+				if square == player {
+					pieces |= 1 << uint(qubicSquare(x, y, z))
+				}
+			}
+		}
+	}
+
+	return pieces
+}
+
+// qubicSquare returns the bit of square x, y, z in a Qubic bitboard.
+func qubicSquare(x, y, z int) int {
+	return x + y*qubicSize + z*qubicSize*qubicSize
+}
+
+// qubicCoordinates returns the coordinates of the square at bit i of a Qubic
+// bitboard.
+func qubicCoordinates(i int) (int, int, int) {
+	return i % qubicSize, i / qubicSize % qubicSize, i / (qubicSize * qubicSize)
+}
+
+// makeQubicLines walks from every square in each of the 13 directions that
+// are not the reverse of another, keeping the walks that stay in the cube for
+// four squares.
+func makeQubicLines() []uint64 {
+	var lines []uint64
+	for dz := -1; dz <= 1; dz++ {
+		for dy := -1; dy <= 1; dy++ {
+			for dx := -1; dx <= 1; dx++ {
+				if dz < 0 || dz == 0 && dy < 0 || dz == 0 && dy == 0 && dx <= 0 {
+					continue
+				}
+				for z := 0; z < qubicSize; z++ {
+					for y := 0; y < qubicSize; y++ {
+						for x := 0; x < qubicSize; x++ {
+							ex, ey, ez := x+(qubicSize-1)*dx, y+(qubicSize-1)*dy, z+(qubicSize-1)*dz
+							if ex < 0 || ex >= qubicSize || ey < 0 || ey >= qubicSize || ez < 0 || ez >= qubicSize {
+								continue
+							}
+
+							var line uint64
+							for i := 0; i < qubicSize; i++ {
+								line |= 1 << uint(qubicSquare(x+i*dx, y+i*dy, z+i*dz))
+							}
+							lines = append(lines, line)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return lines
+}
+
+func makeQubicLinesThrough() [qubicSquares][]uint64 {
+	var through [qubicSquares][]uint64
+	for _, line := range qubicLines {
+		for m := line; m != 0; m &= m - 1 {
+			i := bits.TrailingZeros64(m)
+			through[i] = append(through[i], line)
+		}
+	}
+
+	return through
+}
@@ -0,0 +1,288 @@
+package game
+
+import (
+	"context"
+	"math"
+	"math/bits"
+	"sort"
+	"time"
+)
+
+// qubicTranspositions caches Qubic search results across requests.
+var qubicTranspositions = newTranspositionTable(transpositionTableSize)
+
+// qubicLineWeights scores a line that only one player has pieces in by the
+// number of pieces in it.
+var qubicLineWeights = [qubicSize]int{0, 1, 8, 64}
+
+// scoreQubicFork is the score of a square on which a player would complete
+// two lines of three at once, leaving their opponent unable to block both.
+const scoreQubicFork = 1024
+
+// qubicEngine plays the move found by an iterative deepening alpha-beta
+// search of the cube. The cube is far too large to search to the end, so the
+// search follows forced moves beyond its depth limit, which lets it see long
+// sequences of threats, and scores the quiet positions it stops at by their
+// open lines.
+type qubicEngine struct {
+	budget time.Duration
+}
+
+func (e *qubicEngine) ComputeMove(ctx context.Context, q QubicState) (Move, Evaluation, error) {
+	player := SquareState(q.playersTurn())
+	mine, theirs := q.pieces(player), q.pieces(opponent(player))
+	if mine|theirs == math.MaxUint64 {
+		return Move{}, 0, ErrNoMoves
+	}
+	budget := e.budget
+	if budget == 0 {
+		budget = defaultTimeBudget
+	}
+	score, square, _ := deepenQubic(ctx, mine, theirs, budget)
+	x, y, z := qubicCoordinates(square)
+
+	return Move{X: x, Y: y, Z: z}, Evaluation(score) / scoreWin, nil
+}
+
+// qubicSearch holds the state of a single search of the cube.
+type qubicSearch struct {
+	search
+
+	// first, when searchFirst is set, is searched first at the root.
+	first int
+}
+
+// deepenQubic runs depth limited searches of the position in which the player
+// to move holds the squares of mine and their opponent those of theirs, to
+// successively greater depths as deepen does for boards. It returns the score
+// and square of the best move found, and whether the score is the
+// game-theoretic value of the position.
+func deepenQubic(ctx context.Context, mine, theirs uint64, budget time.Duration) (int, int, bool) {
+	empty := ^(mine | theirs)
+	bestScore, best := 0, bits.TrailingZeros64(empty)
+	solved := false
+	s := &qubicSearch{search: search{table: qubicTranspositions, ctx: ctx}}
+	if budget > 0 {
+		s.deadline = time.Now().Add(budget)
+	}
+	for depth := 1; depth <= bits.OnesCount64(empty); depth++ {
+		s.cutoffs = 0
+		s.rootSearched = 0
+		score, square := s.alphaBeta(mine, theirs, 0, depth, -math.MaxInt32, math.MaxInt32)
+		if s.aborted {
+			if s.rootSearched > 0 {
+				bestScore, best = score, square
+			}
+			break
+		}
+
+		bestScore, best = score, square
+		s.first, s.searchFirst = square, true
+		if s.cutoffs == 0 || isWinScore(score) {
+			solved = true
+			break
+		}
+	}
+
+	return bestScore, best, solved
+}
+
+// alphaBeta searches the position in which the player to move holds the
+// squares of mine, returning its score from their point of view and the
+// square of their best move. Moves forced by the opponent's threats do not
+// count towards the remaining plies.
+func (s *qubicSearch) alphaBeta(mine, theirs uint64, depth, remaining, alpha, beta int) (int, int) {
+	if s.checkAbort() {
+		return 0, 0
+	}
+	s.nodes++
+	empty := ^(mine | theirs)
+	if empty == 0 {
+		return 0, 0
+	}
+
+	// Nothing beats winning on this move, and two threats cannot both be
+	// blocked.
+	if wins := qubicThreats(mine, theirs); wins != 0 {
+		return scoreWin - depth, bits.TrailingZeros64(wins)
+	}
+	moves := empty
+	threats := qubicThreats(theirs, mine)
+	switch bits.OnesCount64(threats) {
+	case 0:
+	case 1:
+		moves = threats
+		remaining++
+	default:
+		return -(scoreWin - depth - 1), bits.TrailingZeros64(threats)
+	}
+
+	key := qubicHash(mine, theirs)
+	if depth > 0 {
+		if e, ok := s.table.load(key); ok && e.draft >= remaining {
+			if e.draft != solvedDraft {
+				s.cutoffs++
+			}
+			score := fromTranspositionScore(e.score, depth)
+			switch {
+			case e.bound == boundExact:
+				return score, 0
+			case e.bound == boundLower && score >= beta:
+				return score, 0
+			case e.bound == boundUpper && score <= alpha:
+				return score, 0
+			case e.bound == boundLower && score > alpha:
+				alpha = score
+			case e.bound == boundUpper && score < beta:
+				beta = score
+			}
+		}
+	}
+	alphaOrig, betaOrig := alpha, beta
+
+	if remaining <= 0 {
+		s.cutoffs++
+		return qubicHeuristic(mine, theirs), 0
+	}
+
+	cutoffs := s.cutoffs
+	best, bestSquare := -math.MaxInt32, 0
+	for _, square := range s.orderMoves(mine, theirs, moves, depth) {
+		score, _ := s.alphaBeta(theirs, mine|1<<uint(square), depth+1, remaining-1, -beta, -alpha)
+		score = -score
+		if s.aborted {
+			return best, bestSquare
+		}
+		if depth == 0 {
+			s.rootSearched++
+		}
+
+		if score > best {
+			best, bestSquare = score, square
+		}
+		if best > alpha {
+			alpha = best
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+
+	b := boundExact
+	if best <= alphaOrig {
+		b = boundUpper
+	} else if best >= betaOrig {
+		b = boundLower
+	}
+	draft := solvedDraft
+	if s.cutoffs != cutoffs {
+		draft = remaining
+	}
+	s.table.store(key, toTranspositionScore(best, depth), b, draft)
+
+	return best, bestSquare
+}
+
+// orderMoves returns the squares of moves in the order the search visits
+// them: the best move of the last search first at the root, then the squares
+// on the most promising lines for either player.
+func (s *qubicSearch) orderMoves(mine, theirs, moves uint64, depth int) []int {
+	var values [qubicSquares]int
+	squares := make([]int, 0, bits.OnesCount64(moves))
+	for m := moves; m != 0; m &= m - 1 {
+		square := bits.TrailingZeros64(m)
+		for _, line := range qubicLinesThrough[square] {
+			if line&theirs == 0 {
+				values[square] += qubicLineWeights[bits.OnesCount64(line&mine)]
+			}
+			if line&mine == 0 {
+				values[square] += qubicLineWeights[bits.OnesCount64(line&theirs)]
+			}
+		}
+		if depth == 0 && s.searchFirst && square == s.first {
+			values[square] = math.MaxInt32
+		}
+		squares = append(squares, square)
+	}
+	sort.SliceStable(squares, func(i, j int) bool {
+		return values[squares[i]] > values[squares[j]]
+	})
+
+	return squares
+}
+
+// qubicThreats returns the empty squares that would complete a line of the
+// squares of mine.
+func qubicThreats(mine, theirs uint64) uint64 {
+	var threats uint64
+	for _, line := range qubicLines {
+		if line&theirs == 0 && bits.OnesCount64(line&mine) == qubicSize-1 {
+			threats |= line &^ mine
+		}
+	}
+
+	return threats
+}
+
+// qubicHeuristic estimates the value of a quiet position, in which neither
+// player has a line of three, to the player to move, who holds the squares
+// of mine. Every line only one player has pieces in counts towards them, and
+// a square on which a player could make two lines of three at once counts
+// for much more, most of all when it is the player to move who can take it.
+func qubicHeuristic(mine, theirs uint64) int {
+	score := 0
+	var myPairs, theirPairs [qubicSquares]int
+	myFork, theirForks := false, 0
+	for _, line := range qubicLines {
+		switch {
+		case line&theirs == 0 && line&mine != 0:
+			n := bits.OnesCount64(line & mine)
+			score += qubicLineWeights[n]
+			if n != qubicSize-2 {
+				continue
+			}
+			for m := line &^ mine; m != 0; m &= m - 1 {
+				square := bits.TrailingZeros64(m)
+				myPairs[square]++
+				if myPairs[square] == 2 {
+					myFork = true
+				}
+			}
+		case line&mine == 0 && line&theirs != 0:
+			n := bits.OnesCount64(line & theirs)
+			score -= qubicLineWeights[n]
+			if n != qubicSize-2 {
+				continue
+			}
+			for m := line &^ theirs; m != 0; m &= m - 1 {
+				square := bits.TrailingZeros64(m)
+				theirPairs[square]++
+				if theirPairs[square] == 2 {
+					theirForks++
+				}
+			}
+		}
+	}
+
+	if myFork {
+		score += scoreQubicFork
+	} else if theirForks > 1 {
+		score -= scoreQubicFork / 2
+	}
+
+	return score
+}
+
+// qubicHash hashes a position by the squares of the player to move and those
+// of their opponent.
+func qubicHash(mine, theirs uint64) uint64 {
+	h := fnvOffset64
+	for _, pieces := range []uint64{mine, theirs} {
+		for i := uint(0); i < qubicSquares; i += 8 {
+			h ^= pieces >> i & 0xff
+			h *= fnvPrime64
+		}
+	}
+
+	return h
+}
@@ -0,0 +1,320 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"math/bits"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// makeQubicState returns a cube holding crosses and naughts on the given
+// squares, each given as x, y, z.
+func makeQubicState(crosses, naughts [][3]int) QubicState {
+	q := QubicState{Board: makeCube()}
+	for _, s := range crosses {
+		q.Board[s[2]][s[1]][s[0]] = SquareStateCross
+	}
+	for _, s := range naughts {
+		q.Board[s[2]][s[1]][s[0]] = SquareStateNaught
+	}
+	q.Turn = len(crosses) + len(naughts) + 1
+
+	return q
+}
+
+func TestQubicLines(t *testing.T) {
+	assert.Len(t, qubicLines, 76)
+
+	seen := make(map[uint64]bool)
+	for _, line := range qubicLines {
+		assert.Equal(t, qubicSize, bits.OnesCount64(line))
+		assert.False(t, seen[line])
+		seen[line] = true
+	}
+
+	// The eight corners and the eight squares at the centre of the cube lie
+	// on seven lines, every other square on four.
+	sevens := 0
+	for square := range qubicLinesThrough {
+		n := len(qubicLinesThrough[square])
+		assert.Contains(t, []int{4, 7}, n)
+		if n == 7 {
+			sevens++
+		}
+	}
+	assert.Equal(t, 16, sevens)
+}
+
+func TestQubic_Initialize(t *testing.T) {
+	row := func(y int) [][3]int { return [][3]int{{0, y, 0}, {1, y, 0}, {2, y, 0}, {3, y, 0}} }
+	tests := []struct {
+		name   string
+		state  QubicState
+		expErr error
+	}{
+		{
+			name:  "Crosses' line after their last move",
+			state: makeQubicState(row(0), [][3]int{{0, 1, 1}, {1, 2, 1}, {3, 3, 3}}),
+		},
+		{
+			name:   "Lines for both players",
+			state:  makeQubicState(row(0), row(1)),
+			expErr: ErrUnreachablePosition,
+		},
+		{
+			name:   "Crosses' line on crosses' turn",
+			state:  makeQubicState(row(0), [][3]int{{0, 1, 1}, {1, 2, 1}, {3, 3, 3}, {2, 2, 2}}),
+			expErr: ErrUnreachablePosition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.state.initialize()
+			assert.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
+
+func TestQubic_GetGameResult(t *testing.T) {
+	tests := []struct {
+		name          string
+		gameState     QubicState
+		want          Result
+		expWinningRow [][3]int
+	}{
+		{
+			name:      "Empty",
+			gameState: makeQubicState(nil, nil),
+			want:      ResultNone,
+		},
+		{
+			name: "Through the layers",
+			gameState: makeQubicState(
+				[][3]int{{1, 2, 0}, {1, 2, 1}, {1, 2, 2}, {1, 2, 3}},
+				[][3]int{{0, 0, 0}, {3, 0, 0}, {0, 3, 0}},
+			),
+			want:          ResultNInARow,
+			expWinningRow: [][3]int{{1, 2, 0}, {1, 2, 1}, {1, 2, 2}, {1, 2, 3}},
+		},
+		{
+			name: "Space diagonal",
+			gameState: makeQubicState(
+				[][3]int{{0, 0, 1}, {1, 0, 1}, {2, 0, 1}, {0, 1, 1}},
+				[][3]int{{3, 0, 0}, {2, 1, 1}, {1, 2, 2}, {0, 3, 3}},
+			),
+			want:          ResultNInARow,
+			expWinningRow: [][3]int{{3, 0, 0}, {2, 1, 1}, {1, 2, 2}, {0, 3, 3}},
+		},
+		{
+			name: "Diagonal within a layer",
+			gameState: makeQubicState(
+				[][3]int{{0, 0, 2}, {1, 1, 2}, {2, 2, 2}, {3, 3, 2}},
+				[][3]int{{0, 0, 0}, {0, 0, 1}, {0, 0, 3}},
+			),
+			want:          ResultNInARow,
+			expWinningRow: [][3]int{{0, 0, 2}, {1, 1, 2}, {2, 2, 2}, {3, 3, 2}},
+		},
+		{
+			name: "Three in a row",
+			gameState: makeQubicState(
+				[][3]int{{0, 0, 0}, {1, 0, 0}, {2, 0, 0}},
+				[][3]int{{3, 0, 0}, {0, 1, 0}},
+			),
+			want: ResultNone,
+		},
+		{
+			name:      "Stalemate",
+			gameState: QubicState{Board: makeCube(), Turn: 65},
+			want:      ResultStalemate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var expWinningRow [][][]SquareState
+			if tt.expWinningRow != nil {
+				expWinningRow = makeCube()
+				first := tt.expWinningRow[0]
+				player := tt.gameState.Board[first[2]][first[1]][first[0]]
+				for _, s := range tt.expWinningRow {
+					expWinningRow[s[2]][s[1]][s[0]] = player
+				}
+			}
+
+			got, gotWinningRow := tt.gameState.getGameResult()
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, expWinningRow, gotWinningRow)
+		})
+	}
+}
+
+func TestQubicEngine_ComputeMove(t *testing.T) {
+	tests := []struct {
+		name      string
+		gameState QubicState
+		expMove   Move
+		expEval   Evaluation
+	}{
+		{
+			name: "Crosses Win",
+			gameState: makeQubicState(
+				[][3]int{{0, 0, 0}, {1, 0, 0}, {2, 0, 0}},
+				[][3]int{{0, 1, 1}, {1, 2, 1}, {3, 3, 3}},
+			),
+			expMove: Move{X: 3, Y: 0, Z: 0},
+			expEval: Evaluation(scoreWin) / scoreWin,
+		},
+		{
+			name: "Crosses Block",
+			gameState: makeQubicState(
+				[][3]int{{0, 1, 0}, {2, 3, 0}, {1, 0, 2}},
+				[][3]int{{0, 0, 3}, {1, 1, 3}, {2, 2, 3}},
+			),
+			expMove: Move{X: 3, Y: 3, Z: 3},
+		},
+		{
+			name: "Crosses Fork",
+			gameState: makeQubicState(
+				[][3]int{{0, 0, 0}, {1, 0, 0}, {3, 1, 0}, {3, 2, 0}},
+				[][3]int{{0, 3, 3}, {2, 1, 2}, {1, 3, 1}, {3, 2, 3}},
+			),
+			expMove: Move{X: 3, Y: 0, Z: 0},
+			expEval: Evaluation(scoreWin-2) / scoreWin,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &qubicEngine{budget: 200 * time.Millisecond}
+			move, eval, err := e.ComputeMove(context.Background(), tt.gameState)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expMove, move)
+			if tt.expEval != 0 {
+				assert.Equal(t, tt.expEval, eval)
+			}
+		})
+	}
+}
+
+func TestQubicEngine_AgainstRandom(t *testing.T) {
+	if testing.Short() {
+		t.Skip("plays a whole game")
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	q := QubicState{Board: makeCube(), Turn: 1}
+	e := &qubicEngine{budget: 20 * time.Millisecond}
+	for {
+		if result, _ := q.getGameResult(); result != ResultNone {
+			break
+		}
+
+		var move Move
+		if q.playersTurn() == rune(SquareStateCross) {
+			var err error
+			move, _, err = e.ComputeMove(context.Background(), q)
+			assert.NoError(t, err)
+		} else {
+			for {
+				move = Move{X: rng.Intn(qubicSize), Y: rng.Intn(qubicSize), Z: rng.Intn(qubicSize)}
+				if q.Board[move.Z][move.Y][move.X] == SquareStateEmpty {
+					break
+				}
+			}
+		}
+		assert.NoError(t, q.occupyPosition(move.X, move.Y, move.Z))
+	}
+
+	// Crosses completed the line, leaving naughts to move.
+	result, _ := q.getGameResult()
+	assert.Equal(t, ResultNInARow, result)
+	assert.Equal(t, rune(SquareStateNaught), q.playersTurn())
+}
+
+func TestQubicStateHandler(t *testing.T) {
+	winning := makeQubicState([][3]int{{0, 0, 0}, {1, 0, 0}, {2, 0, 0}}, [][3]int{{0, 1, 1}, {1, 2, 1}, {3, 3, 3}})
+	b, err := json.Marshal(winning.Board)
+	assert.NoError(t, err)
+	unknown := makeQubicState(nil, nil)
+	unknown.Board[1][2][3] = 'A'
+	u, err := json.Marshal(unknown.Board)
+	assert.NoError(t, err)
+	unbalanced := makeQubicState([][3]int{{0, 0, 0}, {1, 0, 0}}, nil)
+	ub, err := json.Marshal(unbalanced.Board)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+		expTurn       int
+		expResult     Result
+	}{
+		{
+			name:          "Empty cube",
+			body:          `{"variant": "qubic", "timeBudget": 50}`,
+			expStatusCode: http.StatusOK,
+			expTurn:       2,
+		},
+		{
+			name:          "Computer wins",
+			body:          `{"variant": "qubic", "timeBudget": 50, "board": ` + string(b) + `}`,
+			expStatusCode: http.StatusOK,
+			expTurn:       8,
+			expResult:     ResultNInARow,
+		},
+		{
+			name:          "Not a cube",
+			body:          `{"variant": "qubic", "board": [[0,0,0],[0,0,0],[0,0,0]]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Too small",
+			body:          `{"variant": "qubic", "board": [[[0]]]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Unknown symbol",
+			body:          `{"variant": "qubic", "board": ` + string(u) + `}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Second player moved first",
+			body:          `{"variant": "qubic", "board": ` + string(ub) + `}`,
+			expStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "Human to move",
+			body:          `{"variant": "qubic", "humanPlayer": 88}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Negative time budget",
+			body:          `{"variant": "qubic", "timeBudget": -1}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			TicTacToeStateHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+			if tt.expStatusCode != http.StatusOK {
+				return
+			}
+
+			resp := QubicStateResponse{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Len(t, resp.Board, qubicSize)
+			assert.Equal(t, tt.expTurn, resp.Turn)
+			assert.Equal(t, tt.expResult, resp.Result)
+		})
+	}
+}
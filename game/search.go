@@ -0,0 +1,332 @@
+package game
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// scoreWin is the score of a win on the very next move. Wins further down
+// the game tree score one less for every ply needed to reach them so that the
+// search prefers quick wins and drawn-out losses. Heuristic scores of
+// positions at the search's depth limit are kept below scoreWin / 2 so that
+// they never look like a win.
+const scoreWin = 1000000
+
+// solvedDraft is the draft recorded for positions searched to the end of the
+// game.
+const solvedDraft = math.MaxInt32
+
+// abortCheckInterval is the number of nodes searched between checks of the
+// search's deadline and context. On boards of neighbourhoodBoardSize or more,
+// where a single node takes long enough to matter, the search checks at every
+// node instead.
+const abortCheckInterval = 1024
+
+// neighbourhoodBoardSize is the smallest board on which the search only
+// considers the squares within neighbourhood squares of a piece, since there
+// are too many for it to search them all.
+const (
+	neighbourhoodBoardSize = 10
+	neighbourhood          = 2
+)
+
+// isWinScore reports whether a score is a forced win or loss rather than a
+// draw or heuristic estimate.
+func isWinScore(score int) bool {
+	return score > scoreWin/2 || score < -scoreWin/2
+}
+
+// search holds the state of a single game tree search.
+type search struct {
+	table *transpositionTable
+	nodes int
+
+	// maxDepth limits the search to that many plies, with positions at the
+	// limit scored heuristically. Zero means no limit.
+	maxDepth int
+	// cutoffs counts the positions scored heuristically, either at the depth
+	// limit or from the table.
+	cutoffs int
+	// firstX and firstY, when searchFirst is set, are searched first at the
+	// root, placing firstSymbol first when the player may choose.
+	firstX, firstY int
+	firstSymbol    SquareState
+	searchFirst    bool
+	// symbol is the symbol placed by the best move found at the root when
+	// the player may choose, as in the wild variant, and zero otherwise.
+	symbol SquareState
+
+	// rootSearched counts the moves at the root whose scores are known,
+	// which after an aborted search are the only ones to be trusted.
+	rootSearched int
+
+	// The search is aborted once the deadline passes or ctx is done, which
+	// it checks every checkInterval nodes, or abortCheckInterval when zero.
+	ctx           context.Context
+	deadline      time.Time
+	checkInterval int
+	aborted       bool
+}
+
+// draft returns the number of plies left to search below depth.
+func (s *search) draft(depth int) int {
+	if s.maxDepth == 0 {
+		return solvedDraft
+	}
+
+	return s.maxDepth - depth
+}
+
+// checkAbort periodically checks whether the search should stop.
+func (s *search) checkAbort() bool {
+	interval := s.checkInterval
+	if interval == 0 {
+		interval = abortCheckInterval
+	}
+	if s.aborted || s.nodes%interval != 0 {
+		return s.aborted
+	}
+	if s.ctx != nil && s.ctx.Err() != nil {
+		s.aborted = true
+	}
+	if !s.deadline.IsZero() && time.Now().After(s.deadline) {
+		s.aborted = true
+	}
+
+	return s.aborted
+}
+
+// rootMoves orders the moves at the root in the order the search visits
+// them.
+func (s *search) rootMoves(moves [][2]int) [][2]int {
+	if !s.searchFirst {
+		return moves
+	}
+	for i, move := range moves {
+		if move[0] == s.firstX && move[1] == s.firstY {
+			copy(moves[1:i+1], moves[:i])
+			moves[0] = move
+			break
+		}
+	}
+
+	return moves
+}
+
+// rootSymbols orders the symbols placed on x, y at the root in the order the
+// search visits them, so that an aborted search has scored the best move of
+// the last one before the other symbols on its square.
+func (s *search) rootSymbols(x, y int, symbols []SquareState) []SquareState {
+	if !s.searchFirst || x != s.firstX || y != s.firstY || len(symbols) < 2 || symbols[0] == s.firstSymbol {
+		return symbols
+	}
+
+	return []SquareState{symbols[1], symbols[0]}
+}
+
+func (s *search) alphaBeta(gameState TicTacToeState, isMax bool, depth, alpha, beta int) (int, int, int, error) {
+	optimalX := 0
+	optimalY := 0
+	multiplier := 1
+	if !isMax {
+		multiplier = -1
+	}
+	if s.checkAbort() {
+		return 0, optimalX, optimalY, nil
+	}
+	s.nodes++
+	moves, empties, pruned := gameState.candidateMoves()
+	if depth == 0 {
+		moves = s.rootMoves(moves)
+	}
+
+	// Scores in the table are from the point of view of the player whose turn
+	// it is, so the bounds swap when that player is minimizing.
+	var key uint64
+	if s.table != nil {
+		key = gameState.canonicalHash()
+	}
+	if s.table != nil && depth > 0 {
+		if e, ok := s.table.load(key); ok && e.draft >= s.draft(depth) {
+			// A search as deep as there are moves left reached the end.
+			if e.draft < empties {
+				s.cutoffs++
+			}
+			score := fromTranspositionScore(e.score, depth) * multiplier
+			b := e.bound
+			if !isMax && b != boundExact {
+				b = boundLower + boundUpper - b
+			}
+			switch {
+			case b == boundExact:
+				return score, optimalX, optimalY, nil
+			case b == boundLower && score >= beta:
+				return score, optimalX, optimalY, nil
+			case b == boundUpper && score <= alpha:
+				return score, optimalX, optimalY, nil
+			case b == boundLower && score > alpha:
+				alpha = score
+			case b == boundUpper && score < beta:
+				beta = score
+			}
+		}
+	}
+	alphaOrig, betaOrig := alpha, beta
+
+	if s.maxDepth > 0 && depth >= s.maxDepth {
+		s.cutoffs++
+		player := SquareState(gameState.playersTurn())
+		return gameState.heuristic(player) * multiplier, optimalX, optimalY, nil
+	}
+
+	cutoffs := s.cutoffs
+	if pruned {
+		s.cutoffs++
+	}
+	threshold := math.MaxInt32 * -1 * multiplier
+	player := gameState.playersTurn()
+	symbols := gameState.symbols()
+squares:
+	for _, move := range moves {
+		x, y := move[0], move[1]
+		placed := symbols
+		if depth == 0 {
+			placed = s.rootSymbols(x, y, symbols)
+		}
+		for _, symbol := range placed {
+			gs := gameState.clone()
+
+			err := gs.placeSymbol(x, y, symbol)
+			if err != nil {
+				return 0, 0, 0, err
+			}
+
+			var r int
+			result, row := gs.moveResult(x, y)
+			switch winner := gs.winner(result, row); {
+			case result == ResultNone:
+				r, _, _, err = s.alphaBeta(gs, !isMax, depth+1, alpha, beta)
+				if err != nil {
+					return 0, 0, 0, err
+				}
+			case winner == player:
+				// Nothing beats winning on this move.
+				r = (scoreWin - depth) * multiplier
+				s.storeTransposition(key, r, isMax, depth, boundExact, solvedDraft)
+				if depth == 0 && len(symbols) > 1 {
+					s.symbol = symbol
+				}
+				return r, x, y, nil
+			case winner == 0:
+				r = 0
+			default:
+				r = -(scoreWin - depth) * multiplier
+			}
+			if s.aborted {
+				return threshold, optimalX, optimalY, nil
+			}
+			if depth == 0 {
+				s.rootSearched++
+			}
+
+			if (isMax && r > threshold) || (!isMax && r < threshold) {
+				threshold = r
+				optimalX = x
+				optimalY = y
+				if depth == 0 && len(symbols) > 1 {
+					s.symbol = symbol
+				}
+			}
+
+			if isMax && threshold > alpha {
+				alpha = threshold
+			} else if !isMax && threshold < beta {
+				beta = threshold
+			}
+			if alpha >= beta {
+				break squares
+			}
+		}
+	}
+
+	b := boundExact
+	if threshold <= alphaOrig {
+		b = boundUpper
+	} else if threshold >= betaOrig {
+		b = boundLower
+	}
+	draft := solvedDraft
+	if s.cutoffs != cutoffs {
+		draft = s.draft(depth)
+	}
+	s.storeTransposition(key, threshold, isMax, depth, b, draft)
+
+	return threshold, optimalX, optimalY, nil
+}
+
+// storeTransposition records the score of the position identified by key,
+// given from the maximizing player's point of view, in the table.
+func (s *search) storeTransposition(key uint64, score int, isMax bool, depth int, b bound, draft int) {
+	if s.table == nil {
+		return
+	}
+	if !isMax {
+		score = -score
+		if b != boundExact {
+			b = boundLower + boundUpper - b
+		}
+	}
+	s.table.store(key, toTranspositionScore(score, depth), b, draft)
+}
+
+// candidateMoves returns the squares the search considers along with the
+// number of empty squares, and whether legal moves were left out. On boards
+// of neighbourhoodBoardSize or more these are only the empty squares near a
+// piece, or the centre of a board without any, unless it is blocked, and all
+// of them when none is near a piece.
+func (t *TicTacToeState) candidateMoves() ([][2]int, int, bool) {
+	if t.variant() == VariantGravity {
+		return t.gravityMoves(), t.emptyCount(), false
+	}
+	moves := t.emptySquares()
+	n := len(t.Board)
+	if n < neighbourhoodBoardSize {
+		return moves, len(moves), false
+	}
+
+	near := make([]bool, n*n)
+	pieces := false
+	for y := range t.Board {
+		for x := range t.Board[y] {
+			if square := t.Board[y][x]; square == SquareStateEmpty || square == SquareStateBlocked {
+				continue
+			}
+			pieces = true
+			for ny := y - neighbourhood; ny <= y+neighbourhood; ny++ {
+				for nx := x - neighbourhood; nx <= x+neighbourhood; nx++ {
+					if wx, wy := t.wrap(nx, ny); wy >= 0 && wy < n && wx >= 0 && wx < n {
+						near[wy*n+wx] = true
+					}
+				}
+			}
+		}
+	}
+	if !pieces && t.Board[n/2][n/2] == SquareStateEmpty {
+		return [][2]int{{n / 2, n / 2}}, len(moves), true
+	} else if !pieces {
+		return moves, len(moves), false
+	}
+	candidates := make([][2]int, 0, len(moves))
+	for _, move := range moves {
+		if near[move[1]*n+move[0]] {
+			candidates = append(candidates, move)
+		}
+	}
+	if len(candidates) == 0 {
+		// Blocked squares can cut every empty square off from the pieces.
+		return moves, len(moves), false
+	}
+
+	return candidates, len(moves), len(candidates) < len(moves)
+}
@@ -0,0 +1,249 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetup_Initialize(t *testing.T) {
+	x, o, b := SquareStateCross, SquareStateNaught, SquareStateBlocked
+	tests := []struct {
+		name          string
+		gameState     TicTacToeState
+		expErr        error
+		expBoard      [][]SquareState
+		expTurn       int
+		expNextPlayer SquareState
+	}{
+		{
+			name:          "Blocked square",
+			gameState:     TicTacToeState{Blocked: []Square{{X: 1, Y: 1}}},
+			expBoard:      [][]SquareState{{0, 0, 0}, {0, b, 0}, {0, 0, 0}},
+			expTurn:       1,
+			expNextPlayer: x,
+		},
+		{
+			name: "Handicap pieces",
+			gameState: TicTacToeState{
+				Handicap:    []Move{{X: 0, Y: 0, Symbol: x}, {X: 2, Y: 2, Symbol: x}},
+				FirstPlayer: o,
+			},
+			expBoard:      [][]SquareState{{x, 0, 0}, {0, 0, 0}, {0, 0, x}},
+			expTurn:       1,
+			expNextPlayer: o,
+		},
+		{
+			name: "Setup already on the board",
+			gameState: TicTacToeState{
+				Board:       [][]SquareState{{x, 0, b}, {0, o, 0}, {0, 0, x}},
+				Blocked:     []Square{{X: 2, Y: 0}},
+				Handicap:    []Move{{X: 0, Y: 0, Symbol: x}},
+				FirstPlayer: o,
+			},
+			expBoard:      [][]SquareState{{x, 0, b}, {0, o, 0}, {0, 0, x}},
+			expTurn:       3,
+			expNextPlayer: o,
+		},
+		{
+			name:      "Moves the handicap does not account for",
+			gameState: TicTacToeState{Board: [][]SquareState{{x, 0, 0}, {0, o, 0}, {0, 0, o}}, Handicap: []Move{{X: 0, Y: 0, Symbol: x}}},
+			expErr:    ErrTurnImbalance,
+		},
+		{
+			name: "Handicap on a blocked square",
+			gameState: TicTacToeState{
+				Blocked:  []Square{{X: 1, Y: 1}},
+				Handicap: []Move{{X: 1, Y: 1, Symbol: x}},
+			},
+			expErr: ErrInvalidSetup,
+		},
+		{
+			name:      "Board shows another piece",
+			gameState: TicTacToeState{Board: [][]SquareState{{o, 0, 0}, {0, 0, 0}, {0, 0, 0}}, Handicap: []Move{{X: 0, Y: 0, Symbol: x}}},
+			expErr:    ErrInvalidSetup,
+		},
+		{
+			name:      "Off the board",
+			gameState: TicTacToeState{Blocked: []Square{{X: 3, Y: 0}}},
+			expErr:    ErrOutOfBounds,
+		},
+		{
+			name:      "Handicap piece of nobody",
+			gameState: TicTacToeState{Handicap: []Move{{X: 0, Y: 0}}},
+			expErr:    ErrInvalidSetup,
+		},
+		{
+			name:      "Floating blocked square",
+			gameState: TicTacToeState{Variant: VariantGravity, Size: 3, WinLength: 3, Blocked: []Square{{X: 0, Y: 1}}},
+			expErr:    ErrFloatingPiece,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.gameState.initialize()
+			assert.ErrorIs(t, err, tt.expErr)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.expBoard, tt.gameState.Board)
+			assert.Equal(t, tt.expTurn, tt.gameState.Turn)
+			assert.Equal(t, rune(tt.expNextPlayer), tt.gameState.playersTurn())
+		})
+	}
+}
+
+func TestSetup_GetGameResult(t *testing.T) {
+	x, o, b := SquareStateCross, SquareStateNaught, SquareStateBlocked
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		want      Result
+	}{
+		{
+			name:      "Row of blocked squares",
+			gameState: TicTacToeState{Blocked: []Square{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}}},
+			want:      ResultNone,
+		},
+		{
+			name:      "Open squares all played",
+			gameState: TicTacToeState{Board: [][]SquareState{{x, o, x}, {x, b, o}, {o, x, o}}},
+			want:      ResultStalemate,
+		},
+		{
+			name:      "Open square left",
+			gameState: TicTacToeState{Board: [][]SquareState{{x, o, x}, {x, b, o}, {o, x, 0}}},
+			want:      ResultNone,
+		},
+		{
+			name:      "Line of handicap pieces",
+			gameState: TicTacToeState{Handicap: []Move{{X: 0, Y: 0, Symbol: x}, {X: 1, Y: 0, Symbol: x}, {X: 2, Y: 0, Symbol: x}}},
+			want:      ResultNInARow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, tt.gameState.initialize())
+			result, _ := tt.gameState.getGameResult()
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func TestSetup_ComputeMove(t *testing.T) {
+	x, o := SquareStateCross, SquareStateNaught
+	gameState := TicTacToeState{
+		Board:   [][]SquareState{{x, 0, o}, {x, 0, o}, {0, 0, 0}},
+		Blocked: []Square{{X: 1, Y: 1}},
+	}
+	assert.NoError(t, gameState.initialize())
+
+	for _, name := range []string{"minimax", "mcts"} {
+		t.Run(name, func(t *testing.T) {
+			e, err := NewEngine(name, EngineOptions{Iterations: 2000})
+			assert.NoError(t, err)
+			move, _, err := e.ComputeMove(context.Background(), gameState)
+			assert.NoError(t, err)
+			assert.Equal(t, Move{X: 0, Y: 2}, move)
+		})
+	}
+}
+
+func TestSetup_CandidateMoves(t *testing.T) {
+	gameState := TicTacToeState{Size: 15, WinLength: 5, Blocked: []Square{{X: 7, Y: 7}}}
+	assert.NoError(t, gameState.initialize())
+	moves, empties, pruned := gameState.candidateMoves()
+	assert.Len(t, moves, 224)
+	assert.Equal(t, 224, empties)
+	assert.False(t, pruned)
+
+	gameState.Handicap = []Move{{X: 0, Y: 0, Symbol: SquareStateCross}}
+	gameState.FirstPlayer = SquareStateNaught
+	assert.NoError(t, gameState.initialize())
+	moves, _, pruned = gameState.candidateMoves()
+	assert.Len(t, moves, 8)
+	assert.True(t, pruned)
+
+	// Blocked squares cut the only empty square off from the pieces.
+	walled := TicTacToeState{Board: makeBoard(10), WinLength: 3}
+	for y := range walled.Board {
+		for x := range walled.Board[y] {
+			walled.Board[y][x] = SquareStateBlocked
+		}
+	}
+	walled.Board[0][0], walled.Board[0][1], walled.Board[9][9] = SquareStateCross, SquareStateNaught, SquareStateEmpty
+	assert.NoError(t, walled.initialize())
+	moves, empties, pruned = walled.candidateMoves()
+	assert.Equal(t, [][2]int{{9, 9}}, moves)
+	assert.Equal(t, 1, empties)
+	assert.False(t, pruned)
+	_, move, _, err := deepen(context.Background(), walled, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, Move{X: 9, Y: 9}, move)
+}
+
+func TestSetup_Heuristic(t *testing.T) {
+	gameState := TicTacToeState{
+		Board:   [][]SquareState{{SquareStateCross, 0, 0}, {0, 0, 0}, {0, 0, 0}},
+		Blocked: []Square{{X: 1, Y: 1}},
+	}
+	assert.NoError(t, gameState.initialize())
+
+	// The row and column through the cross are open, its diagonal is not.
+	assert.Equal(t, 2*lineWeights[1], gameState.heuristic(SquareStateCross))
+}
+
+func TestSetup_CanonicalHash(t *testing.T) {
+	open := TicTacToeState{Board: makeBoard(3)}
+	blocked := TicTacToeState{Board: makeBoard(3), Blocked: []Square{{X: 1, Y: 1}}}
+	assert.NoError(t, open.initialize())
+	assert.NoError(t, blocked.initialize())
+	assert.NotEqual(t, open.canonicalHash(), blocked.canonicalHash())
+}
+
+func TestSetup_SharedTranspositions(t *testing.T) {
+	// After crosses open in the centre it is naughts' turn, but with a
+	// handicap cross there it is crosses', on the same board.
+	normal := TicTacToeState{Board: makeBoard(3)}
+	handicap := TicTacToeState{Handicap: []Move{{X: 1, Y: 1, Symbol: SquareStateCross}}}
+	assert.NoError(t, normal.initialize())
+	assert.NoError(t, handicap.initialize())
+	assert.NoError(t, normal.occupyPosition(1, 1))
+	assert.NotEqual(t, normal.canonicalHash(), handicap.canonicalHash())
+
+	seeded := &search{table: newTranspositionTable(transpositionTableSize)}
+	seeded.alphaBeta(normal, true, 0, -math.MaxInt32, math.MaxInt32)
+	got, _, _, _ := seeded.alphaBeta(handicap, true, 0, -math.MaxInt32, math.MaxInt32)
+	want, _, _, _ := (&search{}).alphaBeta(handicap, true, 0, -math.MaxInt32, math.MaxInt32)
+	assert.Equal(t, want, got)
+}
+
+func TestSetup_Handler(t *testing.T) {
+	body := `{"blocked": [{"x":1,"y":1}], "handicap": [{"x":0,"y":0,"symbol":88}], "firstPlayer": 48}`
+	r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	TicTacToeStateHandler(w, r, nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	resp := TicTacToeStateResponse{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, SquareStateBlocked, resp.Board[1][1])
+	assert.Equal(t, SquareStateCross, resp.Board[0][0])
+	assert.Equal(t, 2, resp.Turn)
+	assert.Equal(t, rune(SquareStateCross), resp.NextPlayer)
+	naughts := 0
+	for _, row := range resp.Board {
+		for _, square := range row {
+			if square == SquareStateNaught {
+				naughts++
+			}
+		}
+	}
+	assert.Equal(t, 1, naughts)
+}
@@ -0,0 +1,108 @@
+package game
+
+import (
+	"math/bits"
+	"sort"
+)
+
+//go:generate go run solved_gen.go
+
+// Outcomes of a solved position for the player whose turn it is.
+const (
+	outcomeLoss = 0
+	outcomeDraw = 1
+	outcomeWin  = 2
+)
+
+// solvedTableEntrySize is the number of bytes in each entry of solvedTable:
+// the position's key followed by its solution, both big endian uint16s. The
+// key holds the board's squares, from the top left to the bottom right, as
+// the digits of a base 3 number with the top left as the least significant
+// digit, where 0 is empty, 1 a cross and 2 a naught. The solution holds the
+// best moves in bits 0 to 8, one for each square in the same order, the
+// distance to the end of the game in bits 9 to 12 and the outcome in bits 13
+// and 14.
+const solvedTableEntrySize = 4
+
+// solvedPosition is the game-theoretic value of a position under perfect play.
+type solvedPosition struct {
+	// outcome is the outcome for the player whose turn it is.
+	outcome int
+	// distance is the number of plies until the game ends.
+	distance int
+	// bestMoves has a bit set for every move that achieves the outcome in
+	// distance plies, bit y*3+x for the square x, y.
+	bestMoves uint16
+}
+
+// score converts the solution into the score the search gives the best move
+// for the player whose turn it is.
+func (p solvedPosition) score() int {
+	switch p.outcome {
+	case outcomeWin:
+		return scoreWin - (p.distance - 1)
+	case outcomeLoss:
+		return -(scoreWin - (p.distance - 1))
+	}
+
+	return 0
+}
+
+// bestMove returns the coordinates of the first of the best moves, in the
+// order the search visits them.
+func (p solvedPosition) bestMove() (int, int) {
+	i := bits.TrailingZeros16(p.bestMoves)
+	return i % 3, i / 3
+}
+
+// lookupSolved looks up a classic 3x3 position in the table generated by
+// solved_gen.go. The table has crosses move first, so when naughts move first
+// the players swap places. It reports false for other boards, win lengths and
+// variants, for finished games and for unreachable positions.
+func lookupSolved(gameState TicTacToeState) (solvedPosition, bool) {
+	if len(gameState.Board) != 3 || gameState.winLength() != 3 ||
+		gameState.variant() != VariantStandard {
+		return solvedPosition{}, false
+	}
+
+	var key uint16
+	pieces := 0
+	for y := 2; y >= 0; y-- {
+		if len(gameState.Board[y]) != 3 {
+			return solvedPosition{}, false
+		}
+		for x := 2; x >= 0; x-- {
+			square := gameState.Board[y][x]
+			order := gameState.order(square)
+			if order == 0 && square != SquareStateEmpty {
+				return solvedPosition{}, false
+			}
+			if order != 0 {
+				pieces++
+			}
+			key = key*3 + uint16(order)
+		}
+	}
+	if gameState.Turn != pieces+1 {
+		return solvedPosition{}, false
+	}
+
+	n := len(solvedTable) / solvedTableEntrySize
+	i := sort.Search(n, func(i int) bool {
+		return solvedTableUint16(i*solvedTableEntrySize) >= key
+	})
+	if i == n || solvedTableUint16(i*solvedTableEntrySize) != key {
+		return solvedPosition{}, false
+	}
+	value := solvedTableUint16(i*solvedTableEntrySize + 2)
+
+	return solvedPosition{
+		outcome:   int(value >> 13 & 0x3),
+		distance:  int(value >> 9 & 0xf),
+		bestMoves: value & 0x1ff,
+	}, true
+}
+
+func solvedTableUint16(offset int) uint16 {
+	return uint16(solvedTable[offset])<<8 | uint16(solvedTable[offset+1])
+}
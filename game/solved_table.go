@@ -0,0 +1,572 @@
+// Code generated by solved_gen.go; DO NOT EDIT.
+
+package game
+
+// solvedTable holds the 4520 positions of 3x3 tic-tac-toe that can be
+// reached without the game having ended. See solved.go for its format.
+const solvedTable = "" +
+	"\x00\x00\x33\xff\x00\x01\x30\x10\x00\x03\x30\x95\x00\x05\x2f\x58\x00\x07\x4a\x58\x00\x09\x30\x10\x00\x0b\x4b\x60\x00\x0e\x4a\x48" +
+	"\x00\x0f\x4b\x30\x00\x10\x2c\x10\x00\x13\x4b\x48\x00\x15\x2f\x70\x00\x16\x4b\x20\x00\x1b\x30\x71\x00\x1d\x2f\x16\x00\x20\x2c\xb0" +
+	"\x00\x21\x4a\x11\x00\x22\x08\x40\x00\x26\x2c\x30\x00\x2a\x2c\x10\x00\x2c\x46\x30\x00\x2d\x4a\x01\x00\x2e\x08\x40\x00\x30\x4b\x00" +
+	"\x00\x32\x46\x10\x00\x34\x42\x40\x00\x37\x4a\x16\x00\x39\x4a\x11\x00\x3a\x08\x04\x00\x3f\x4b\x11\x00\x40\x08\x02\x00\x42\x4a\x01" +
+	"\x00\x44\x08\x40\x00\x46\x47\x10\x00\x4c\x46\x10\x00\x51\x31\x45\x00\x53\x2f\xee\x00\x56\x2c\x80\x00\x57\x4b\x6d\x00\x58\x09\x00" +
+	"\x00\x5c\x2c\x40\x00\x60\x08\x40\x00\x62\x42\x40\x00\x63\x2f\xeb\x00\x64\x2d\x00\x00\x66\x2c\x80\x00\x68\x42\x80\x00\x6a\x43\x00" +
+	"\x00\x6e\x2c\x20\x00\x72\x08\x20\x00\x74\x42\x20\x00\x7d\x05\xe0\x00\x7e\x2c\x20\x00\x80\x42\x20\x00\x83\x05\xe0\x00\x84\x42\x20" +
+	"\x00\x85\x05\xe0\x00\x87\x4b\xc7\x00\x88\x09\x00\x00\x8a\x08\x80\x00\x8c\x42\x80\x00\x8e\x43\x00\x00\x90\x08\x40\x00\x92\x42\x40" +
+	"\x00\x95\x42\x40\x00\x96\x42\x40\x00\x97\x05\xe0\x00\x9a\x43\x00\x00\x9c\x42\x80\x00\x9d\x05\xe0\x00\xa3\x2f\xee\x00\xa5\x2f\x6d" +
+	"\x00\xa6\x2c\x04\x00\xab\x2f\xeb\x00\xac\x2c\x02\x00\xae\x2c\x01\x00\xb0\x2b\x00\x00\xb2\x2a\x80\x00\xb8\x2a\x40\x00\xbd\x2f\xc7" +
+	"\x00\xbe\x2c\x40\x00\xc0\x2c\x45\x00\xc2\x2b\x00\x00\xc4\x42\x40\x00\xc6\x2c\xc3\x00\xc8\x2b\x00\x00\xcb\x43\x00\x00\xcc\x2a\x80" +
+	"\x00\xcd\x42\x80\x00\xd0\x42\x40\x00\xd2\x2a\x40\x00\xd3\x42\x40\x00\xdc\x42\x04\x00\xe2\x42\x02\x00\xe4\x42\x01\x00\xf3\x31\x1c" +
+	"\x00\xf5\x4a\x04\x00\xf8\x4a\x40\x00\xf9\x4a\x14\x00\xfa\x2c\x10\x00\xfe\x09\x00\x01\x02\x09\x00\x01\x04\x43\x00\x01\x05\x2e\x53" +
+	"\x01\x06\x2c\x18\x01\x08\x2c\x98\x01\x0a\x46\x10\x01\x0c\x46\x18\x01\x10\x4a\x10\x01\x14\x4a\x10\x01\x16\x42\x10\x01\x1f\x05\xd0" +
+	"\x01\x20\x4a\x10\x01\x22\x42\x10\x01\x25\x46\x10\x01\x26\x42\x10\x01\x27\x05\xd0\x01\x29\x2f\xd7\x01\x2a\x2d\x04\x01\x2c\x2d\x04" +
+	"\x01\x2e\x4a\x40\x01\x30\x47\x00\x01\x32\x2d\x00\x01\x34\x43\x00\x01\x37\x42\x40\x01\x38\x43\x00\x01\x39\x29\x00\x01\x3c\x2b\xd2" +
+	"\x01\x3e\x2a\xd1\x01\x3f\x29\x90\x01\x46\x2c\x08\x01\x4a\x08\x08\x01\x4c\x42\x08\x01\x55\x05\xc8\x01\x56\x2c\x08\x01\x58\x42\x08" +
+	"\x01\x5b\x05\xc8\x01\x5c\x42\x08\x01\x5d\x05\xc8\x01\x7a\x2d\x45\x01\x7c\x2a\x40\x01\x7f\x42\x40\x01\x80\x47\x04\x01\x81\x29\x00" +
+	"\x01\x85\x42\x40\x01\x89\x05\xc1\x01\x8b\x43\x40\x01\x8c\x2a\xc3\x01\x8d\x29\x00\x01\x8f\x28\x80\x01\x91\x42\x80\x01\x93\x43\x00" +
+	"\x01\x95\x2f\xc7\x01\x96\x2d\x86\x01\x98\x2d\x05\x01\x9a\x2b\x00\x01\x9c\x2a\x80\x01\x9e\x2d\x00\x01\xa0\x43\x00\x01\xa3\x43\x00" +
+	"\x01\xa4\x43\x00\x01\xa5\x42\x80\x01\xa8\x2a\x40\x01\xaa\x2a\x40\x01\xab\x42\x40\x01\xb0\x4b\xc7\x01\xb2\x09\x00\x01\xb5\x43\x00" +
+	"\x01\xb6\x08\x80\x01\xb7\x42\x80\x01\xbb\x43\x00\x01\xbf\x42\x80\x01\xc1\x43\x00\x01\xc2\x08\x40\x01\xc3\x42\x40\x01\xc5\x42\x40" +
+	"\x01\xc7\x05\xc0\x01\xc9\x42\x40\x01\xcc\x46\x04\x01\xce\x46\x04\x01\xcf\x28\x04\x01\xd4\x43\x00\x01\xd5\x05\xc2\x01\xd7\x05\xc1" +
+	"\x01\xd9\x43\x00\x01\xdb\x43\x00\x01\xe1\x26\x40\x01\xe7\x4a\x54\x01\xe9\x4a\x14\x01\xea\x4a\x04\x01\xef\x4a\x13\x01\xf0\x08\x02" +
+	"\x01\xf2\x08\x01\x01\xf4\x46\x10\x01\xf6\x46\x50\x01\xfc\x09\x00\x02\x01\x2f\xd7\x02\x02\x2c\x40\x02\x04\x2c\x41\x02\x06\x2b\x94" +
+	"\x02\x08\x42\x40\x02\x0a\x2c\x41\x02\x0c\x2b\xd2\x02\x0f\x28\xd0\x02\x10\x46\x40\x02\x11\x28\x40\x02\x14\x42\x40\x02\x16\x4b\x00" +
+	"\x02\x17\x43\x00\x02\x20\x42\x04\x02\x26\x42\x02\x02\x28\x42\x01\x02\x37\x4b\xc7\x02\x38\x09\x00\x02\x3a\x08\x80\x02\x3c\x42\x80" +
+	"\x02\x3e\x43\x00\x02\x40\x08\x40\x02\x42\x42\x40\x02\x45\x05\xc8\x02\x46\x42\x40\x02\x47\x05\xc8\x02\x4a\x43\x00\x02\x4c\x42\x80" +
+	"\x02\x4d\x43\x00\x02\x52\x2d\x45\x02\x54\x2b\x86\x02\x57\x28\x80\x02\x58\x46\x41\x02\x59\x05\xc4\x02\x5d\x28\x40\x02\x61\x28\x40" +
+	"\x02\x63\x42\x40\x02\x64\x2b\x00\x02\x65\x43\x00\x02\x67\x43\x00\x02\x69\x42\x80\x02\x6b\x43\x40\x02\x6e\x43\x00\x02\x70\x42\x80" +
+	"\x02\x71\x05\xc4\x02\x76\x42\x40\x02\x77\x05\xc2\x02\x79\x05\xc1\x02\x7b\x42\xc0\x02\x7d\x43\x40\x02\x83\x43\x80\x02\x8c\x42\x04" +
+	"\x02\x92\x42\x02\x02\x94\x42\x01\x02\xa4\x42\x40\x02\xa6\x46\x01\x02\xa7\x05\xc4\x02\xac\x46\x01\x02\xad\x05\xc2\x02\xaf\x28\x01" +
+	"\x02\xb1\x27\x00\x02\xb3\x42\x40\x02\xb9\x42\x40\x02\xd9\x30\x10\x02\xdb\x4b\x84\x02\xde\x2c\x90\x02\xdf\x4b\x11\x02\xe0\x08\x08" +
+	"\x02\xe4\x08\x10\x02\xe8\x2c\x10\x02\xea\x42\x10\x02\xeb\x4b\x01\x02\xec\x08\x08\x02\xee\x2d\x90\x02\xf0\x46\x80\x02\xf2\x42\x08" +
+	"\x02\xf6\x4a\x06\x02\xfa\x4a\x01\x02\xfc\x08\x04\x03\x05\x46\x10\x03\x06\x4a\x01\x03\x08\x08\x02\x03\x0b\x47\x00\x03\x0c\x42\x01" +
+	"\x03\x0f\x4b\x90\x03\x10\x2c\x10\x03\x12\x2c\x10\x03\x14\x46\x90\x03\x16\x47\x10\x03\x18\x2c\x10\x03\x1a\x42\x10\x03\x1d\x46\x10" +
+	"\x03\x1e\x42\x10\x03\x1f\x46\x10\x03\x22\x47\x00\x03\x24\x46\x80\x03\x25\x46\x20\x03\x2c\x2c\x04\x03\x30\x08\x04\x03\x32\x42\x04" +
+	"\x03\x3c\x2d\x01\x03\x3e\x2a\x02\x03\x41\x28\x80\x03\x42\x46\x01\x03\x43\x05\xa8\x03\x4d\x42\x04\x03\x59\x42\x02\x03\x5d\x42\x01" +
+	"\x03\x60\x08\x04\x03\x62\x42\x04\x03\x65\x05\xa4\x03\x66\x42\x04\x03\x67\x05\xa4\x03\x72\x47\x80\x03\x73\x29\x00\x03\x75\x28\x80" +
+	"\x03\x77\x42\x80\x03\x79\x43\x00\x03\x7b\x2f\xaf\x03\x7c\x2c\x08\x03\x7e\x2c\x2d\x03\x80\x2b\x00\x03\x82\x42\x08\x03\x84\x2c\xaa" +
+	"\x03\x86\x47\x00\x03\x89\x43\x00\x03\x8a\x2a\x80\x03\x8b\x42\x80\x03\x8e\x42\x08\x03\x90\x2b\x29\x03\x91\x28\x08\x03\x96\x2c\x01" +
+	"\x03\x98\x2b\x00\x03\x9b\x43\x00\x03\x9c\x42\x01\x03\xa1\x43\x00\x03\xa5\x42\x80\x03\xa7\x05\xa0\x03\xa8\x42\x01\x03\xab\x28\x01" +
+	"\x03\xad\x27\x00\x03\xb2\x2a\x20\x03\xb4\x2a\x20\x03\xb5\x42\x20\x03\xba\x2a\x20\x03\xbb\x42\x20\x03\xbd\x42\x20\x03\xbf\x05\xa0" +
+	"\x03\xc1\x05\xa0\x03\xc7\x26\x20\x03\xce\x2c\x04\x03\xd2\x2c\x10\x03\xd4\x46\x04\x03\xdd\x05\x98\x03\xde\x2c\x19\x03\xe0\x2a\x02" +
+	"\x03\xe3\x28\x90\x03\xe4\x4a\x01\x03\xe5\x08\x08\x03\xef\x42\x04\x03\xfb\x42\x02\x03\xff\x42\x01\x04\x02\x2d\x04\x04\x04\x47\x04" +
+	"\x04\x07\x09\x94\x04\x08\x47\x04\x04\x09\x29\x10\x04\x0d\x05\x92\x04\x11\x05\x91\x04\x13\x43\x10\x04\x14\x2b\x93\x04\x15\x29\x90" +
+	"\x04\x17\x29\x90\x04\x19\x46\x80\x04\x1b\x47\x00\x04\x25\x42\x04\x04\x31\x42\x02\x04\x35\x42\x01\x04\x55\x28\x04\x04\x59\x28\x04" +
+	"\x04\x5b\x42\x04\x04\x65\x29\x83\x04\x67\x26\x02\x04\x6a\x24\x80\x04\x6b\x26\x01\x04\x6c\x25\x00\x04\x6e\x2d\x86\x04\x70\x47\x00" +
+	"\x04\x73\x43\x00\x04\x74\x2a\x80\x04\x75\x42\x80\x04\x79\x43\x00\x04\x7d\x42\x80\x04\x7f\x43\x00\x04\x80\x2b\x83\x04\x81\x28\x08" +
+	"\x04\x83\x29\x89\x04\x85\x27\x00\x04\x87\x42\x08\x04\x8b\x43\x00\x04\x8f\x42\x80\x04\x91\x05\x84\x04\x9a\x43\x80\x04\x9b\x46\x01" +
+	"\x04\x9d\x05\x82\x04\xa0\x43\x00\x04\xa1\x42\x01\x04\xa4\x47\x00\x04\xa5\x29\x86\x04\xa7\x29\x04\x04\xa9\x47\x00\x04\xab\x26\x80" +
+	"\x04\xad\x29\x00\x04\xaf\x43\x00\x04\xb2\x43\x00\x04\xb3\x43\x00\x04\xb4\x42\x80\x04\xb7\x27\x82\x04\xb9\x27\x81\x04\xba\x25\x80" +
+	"\x04\xbf\x4b\x11\x04\xc0\x08\x08\x04\xc2\x2c\x10\x04\xc4\x46\x90\x04\xc6\x42\x08\x04\xc8\x2c\x10\x04\xca\x42\x10\x04\xcd\x46\x10" +
+	"\x04\xce\x42\x10\x04\xcf\x05\x98\x04\xd2\x42\x08\x04\xd4\x4b\x00\x04\xd5\x43\x00\x04\xda\x2c\x01\x04\xdc\x2b\x04\x04\xdf\x47\x00" +
+	"\x04\xe0\x42\x01\x04\xe5\x28\x10\x04\xe9\x05\x91\x04\xeb\x42\x10\x04\xec\x42\x01\x04\xef\x43\x00\x04\xf1\x27\x00\x04\xf6\x46\x10" +
+	"\x04\xf8\x46\x10\x04\xf9\x42\x10\x04\xfe\x42\x10\x04\xff\x42\x10\x05\x01\x42\x10\x05\x03\x42\x10\x05\x05\x42\x10\x05\x0b\x05\x90" +
+	"\x05\x10\x08\x04\x05\x12\x42\x04\x05\x15\x05\x8c\x05\x16\x42\x04\x05\x17\x05\x8c\x05\x22\x47\x00\x05\x23\x43\x00\x05\x25\x43\x00" +
+	"\x05\x27\x42\x80\x05\x29\x43\x08\x05\x2d\x46\x04\x05\x31\x05\x85\x05\x33\x42\x04\x05\x3d\x43\x00\x05\x3f\x05\x82\x05\x42\x43\x00" +
+	"\x05\x43\x42\x01\x05\x46\x42\x04\x05\x47\x05\x86\x05\x49\x05\x85\x05\x4b\x42\x84\x05\x4d\x43\x04\x05\x59\x43\x00\x05\x5b\x42\x80" +
+	"\x05\x5c\x43\x00\x05\x62\x42\x08\x05\x64\x2a\x08\x05\x65\x42\x08\x05\x6a\x2a\x08\x05\x6b\x42\x08\x05\x6d\x42\x08\x05\x6f\x05\x88" +
+	"\x05\x71\x42\x08\x05\x77\x42\x08\x05\x7c\x42\x01\x05\x7f\x28\x01\x05\x81\x27\x00\x05\x85\x28\x01\x05\x87\x27\x00\x05\x8a\x43\x00" +
+	"\x05\x8b\x42\x01\x05\x91\x42\x01\x05\xb3\x4b\x06\x05\xb5\x4a\x01\x05\xb6\x08\x04\x05\xbb\x4b\x01\x05\xbc\x08\x02\x05\xbe\x4a\x01" +
+	"\x05\xc0\x08\x08\x05\xc2\x47\x00\x05\xc8\x46\x10\x05\xcd\x2f\x94\x05\xce\x4b\x80\x05\xd0\x4b\x00\x05\xd2\x46\x10\x05\xd4\x46\x10" +
+	"\x05\xd6\x2d\x30\x05\xd8\x46\x20\x05\xdb\x47\x00\x05\xdc\x46\x20\x05\xdd\x46\x80\x05\xe0\x46\x10\x05\xe2\x46\x10\x05\xe3\x42\x10" +
+	"\x05\xec\x42\x04\x05\xf2\x42\x02\x05\xf4\x42\x01\x06\x03\x2f\xaf\x06\x04\x2d\x00\x06\x06\x2c\x80\x06\x08\x42\x80\x06\x0a\x43\x00" +
+	"\x06\x0c\x2d\x01\x06\x0e\x2a\x08\x06\x11\x42\x08\x06\x12\x47\x20\x06\x13\x29\x00\x06\x16\x43\x00\x06\x18\x42\x80\x06\x19\x05\xa8" +
+	"\x06\x1e\x2c\x20\x06\x20\x42\x20\x06\x23\x05\xa4\x06\x24\x42\x20\x06\x25\x05\xa4\x06\x29\x28\x20\x06\x2d\x28\x20\x06\x2f\x42\x20" +
+	"\x06\x30\x42\x20\x06\x31\x05\xa2\x06\x33\x05\xa1\x06\x35\x42\xa0\x06\x37\x43\x20\x06\x3a\x43\x00\x06\x3c\x42\x80\x06\x3d\x05\xa4" +
+	"\x06\x42\x46\x01\x06\x43\x05\xa2\x06\x45\x42\x01\x06\x49\x43\x00\x06\x4f\x43\x80\x06\x58\x42\x04\x06\x5e\x42\x02\x06\x60\x42\x01" +
+	"\x06\x70\x2a\x04\x06\x72\x2a\x04\x06\x73\x42\x04\x06\x78\x2b\x83\x06\x79\x28\x02\x06\x7b\x28\x01\x06\x7d\x27\x00\x06\x7f\x26\x80" +
+	"\x06\xa5\x4b\x00\x06\xa6\x2d\x00\x06\xa8\x4b\x01\x06\xaa\x08\x08\x06\xac\x47\x10\x06\xae\x4b\x00\x06\xb0\x43\x00\x06\xb3\x42\x08" +
+	"\x06\xb4\x43\x00\x06\xb5\x29\x00\x06\xb8\x46\x10\x06\xba\x46\x10\x06\xbb\x42\x10\x06\xc0\x4a\x10\x06\xc2\x42\x10\x06\xc5\x46\x10" +
+	"\x06\xc6\x42\x10\x06\xc7\x46\x10\x06\xcb\x05\x92\x06\xcf\x05\x91\x06\xd1\x43\x10\x06\xd2\x42\x10\x06\xd3\x42\x10\x06\xd5\x42\x10" +
+	"\x06\xd7\x42\x10\x06\xd9\x42\x10\x06\xdc\x47\x04\x06\xde\x4a\x01\x06\xdf\x08\x04\x06\xe4\x43\x00\x06\xe5\x05\x92\x06\xe7\x42\x01" +
+	"\x06\xeb\x43\x00\x06\xf1\x46\x10\x06\xf6\x2c\x08\x06\xf8\x42\x08\x06\xfb\x42\x08\x06\xfc\x42\x08\x06\xfd\x05\x8c\x07\x01\x42\x08" +
+	"\x07\x05\x05\x89\x07\x07\x43\x08\x07\x08\x42\x08\x07\x09\x05\x8a\x07\x0b\x05\x89\x07\x0d\x42\x88\x07\x0f\x43\x08\x07\x2c\x2a\x01" +
+	"\x07\x2d\x29\x00\x07\x2f\x42\x01\x07\x33\x43\x00\x07\x35\x42\x01\x07\x3b\x43\x00\x07\x3c\x25\x00\x07\x3f\x43\x00\x07\x41\x42\x80" +
+	"\x07\x42\x05\x80\x07\x48\x46\x04\x07\x4a\x46\x04\x07\x4b\x42\x04\x07\x50\x43\x00\x07\x51\x05\x8a\x07\x53\x05\x89\x07\x55\x43\x00" +
+	"\x07\x57\x43\x00\x07\x62\x08\x04\x07\x63\x42\x04\x07\x65\x42\x04\x07\x67\x05\x84\x07\x69\x05\x84\x07\x6b\x47\x00\x07\x6d\x43\x00" +
+	"\x07\x70\x43\x00\x07\x71\x43\x00\x07\x72\x42\x80\x07\x81\x42\x04\x07\x87\x43\x02\x07\x89\x43\x01\x07\x9c\x42\x04\x07\xa2\x42\x02" +
+	"\x07\xa4\x42\x01\x07\xb4\x2b\x04\x07\xb6\x2b\x94\x07\xb7\x46\x04\x07\xbc\x2b\x93\x07\xbd\x28\x02\x07\xbf\x28\x01\x07\xc1\x27\x90" +
+	"\x07\xc3\x27\x90\x07\xc9\x05\x90\x07\xea\x43\x00\x07\xec\x42\x80\x07\xed\x05\x8c\x07\xf2\x46\x03\x07\xf3\x05\x8a\x07\xf5\x05\x89" +
+	"\x07\xf7\x42\x80\x07\xf9\x43\x00\x07\xff\x43\x80\x08\x04\x2b\x86\x08\x05\x47\x00\x08\x07\x28\x80\x08\x09\x42\x80\x08\x0b\x43\x00" +
+	"\x08\x0d\x29\x83\x08\x0f\x27\x82\x08\x12\x24\x80\x08\x13\x27\x81\x08\x14\x25\x00\x08\x17\x43\x00\x08\x19\x42\x80\x08\x1a\x43\x00" +
+	"\x08\x23\x43\x84\x08\x29\x43\x02\x08\x2b\x42\x81\x08\x59\x42\x04\x08\x5f\x42\x02\x08\x61\x42\x01\x08\x8b\x31\x52\x08\x8d\x4a\x40" +
+	"\x08\x90\x4a\x10\x08\x91\x2f\x7d\x08\x92\x2d\x40\x08\x96\x2c\x40\x08\x9a\x2d\x40\x08\x9c\x47\x40\x08\x9d\x4b\x00\x08\x9e\x2d\x00" +
+	"\x08\xa0\x4a\x10\x08\xa2\x42\x10\x08\xa4\x47\x40\x08\xa8\x4a\x04\x08\xac\x2d\x40\x08\xae\x4a\x04\x08\xb7\x09\x70\x08\xb8\x4b\x01" +
+	"\x08\xba\x08\x02\x08\xbd\x46\x10\x08\xbe\x4a\x01\x08\xbf\x08\x40\x08\xc1\x4a\x50\x08\xc2\x2c\x10\x08\xc4\x4a\x10\x08\xc6\x42\x10" +
+	"\x08\xc8\x47\x00\x08\xca\x2c\x10\x08\xcc\x46\x40\x08\xcf\x42\x40\x08\xd0\x47\x40\x08\xd1\x29\x10\x08\xd4\x47\x10\x08\xd6\x42\x10" +
+	"\x08\xd7\x46\x10\x08\xde\x2c\x02\x08\xe2\x2d\x45\x08\xe4\x2a\x04\x08\xed\x28\x40\x08\xee\x2c\x02\x08\xf0\x42\x02\x08\xf4\x2a\x01" +
+	"\x08\xf5\x29\x00\x08\xff\x42\x04\x09\x0b\x42\x02\x09\x0f\x42\x01\x09\x12\x08\x02\x09\x14\x42\x02\x09\x18\x47\x40\x09\x19\x29\x00" +
+	"\x09\x1d\x42\x40\x09\x21\x28\x40\x09\x23\x42\x40\x09\x24\x42\x02\x09\x25\x05\x62\x09\x2b\x43\x00\x09\x2d\x2f\x6d\x09\x2e\x2d\x68" +
+	"\x09\x30\x4b\x6d\x09\x32\x09\x00\x09\x34\x46\x40\x09\x36\x2d\x68\x09\x38\x47\x00\x09\x3b\x43\x00\x09\x3c\x47\x00\x09\x3d\x29\x68" +
+	"\x09\x40\x46\x40\x09\x42\x08\x40\x09\x43\x42\x40\x09\x48\x2d\x41\x09\x4a\x2b\x00\x09\x4d\x43\x00\x09\x4e\x46\x40\x09\x4f\x28\x40" +
+	"\x09\x53\x43\x00\x09\x57\x29\x40\x09\x59\x47\x00\x09\x5a\x46\x40\x09\x5b\x42\x40\x09\x5d\x42\x40\x09\x5f\x05\x60\x09\x61\x42\x40" +
+	"\x09\x64\x2a\x20\x09\x66\x08\x20\x09\x67\x42\x20\x09\x6c\x2a\x20\x09\x6d\x42\x20\x09\x6f\x42\x20\x09\x71\x05\x60\x09\x73\x26\x20" +
+	"\x09\x79\x05\x60\x09\x80\x4a\x44\x09\x84\x2d\x40\x09\x86\x4a\x04\x09\x8f\x09\x00\x09\x90\x4a\x01\x09\x92\x08\x02\x09\x95\x46\x10" +
+	"\x09\x96\x4a\x01\x09\x97\x09\x58\x09\xa1\x42\x04\x09\xad\x42\x02\x09\xb1\x42\x01\x09\xb4\x2d\x04\x09\xb6\x4a\x40\x09\xb9\x42\x40" +
+	"\x09\xba\x47\x00\x09\xbb\x29\x00\x09\xbf\x42\x40\x09\xc3\x29\x00\x09\xc5\x43\x00\x09\xc6\x2a\x53\x09\xc7\x29\x10\x09\xc9\x28\x10" +
+	"\x09\xcb\x42\x10\x09\xcd\x47\x00\x09\xd7\x42\x04\x09\xe3\x42\x02\x09\xe7\x42\x01\x0a\x07\x42\x40\x0a\x0b\x46\x01\x0a\x0d\x05\x44" +
+	"\x0a\x16\x42\x40\x0a\x17\x28\x02\x0a\x19\x42\x02\x0a\x1d\x26\x01\x0a\x1e\x25\x00\x0a\x20\x2d\x44\x0a\x22\x47\x00\x0a\x25\x43\x00" +
+	"\x0a\x26\x47\x00\x0a\x27\x29\x40\x0a\x2b\x43\x00\x0a\x2f\x29\x00\x0a\x31\x43\x00\x0a\x32\x2a\x40\x0a\x33\x42\x40\x0a\x35\x42\x40" +
+	"\x0a\x37\x05\x48\x0a\x39\x46\x40\x0a\x3d\x43\x00\x0a\x41\x46\x05\x0a\x43\x05\x44\x0a\x4c\x43\x00\x0a\x4d\x42\x40\x0a\x4f\x05\x42" +
+	"\x0a\x52\x43\x40\x0a\x53\x05\x41\x0a\x54\x42\x40\x0a\x56\x47\x00\x0a\x57\x29\x04\x0a\x59\x46\x41\x0a\x5b\x05\x44\x0a\x5d\x47\x00" +
+	"\x0a\x5f\x29\x00\x0a\x61\x43\x00\x0a\x64\x43\x40\x0a\x65\x43\x00\x0a\x66\x25\x00\x0a\x69\x26\x40\x0a\x6b\x26\x40\x0a\x6c\x42\x40" +
+	"\x0a\x71\x4b\x10\x0a\x72\x2c\x10\x0a\x74\x4a\x10\x0a\x76\x42\x10\x0a\x78\x47\x40\x0a\x7a\x2c\x10\x0a\x7c\x46\x50\x0a\x7f\x46\x10" +
+	"\x0a\x80\x46\x40\x0a\x81\x28\x50\x0a\x84\x47\x00\x0a\x86\x42\x10\x0a\x87\x43\x00\x0a\x8c\x2c\x41\x0a\x8e\x2b\x16\x0a\x91\x28\x10" +
+	"\x0a\x92\x46\x40\x0a\x93\x28\x40\x0a\x97\x28\x50\x0a\x9b\x28\x40\x0a\x9d\x46\x40\x0a\x9e\x4b\x00\x0a\x9f\x43\x00\x0a\xa1\x43\x00" +
+	"\x0a\xa3\x42\x10\x0a\xa5\x42\x40\x0a\xa8\x46\x10\x0a\xaa\x42\x10\x0a\xab\x42\x10\x0a\xb0\x46\x10\x0a\xb1\x42\x10\x0a\xb3\x42\x10" +
+	"\x0a\xb5\x42\x10\x0a\xb7\x46\x10\x0a\xbd\x42\x10\x0a\xc2\x08\x02\x0a\xc4\x42\x02\x0a\xc8\x47\x40\x0a\xc9\x29\x00\x0a\xcd\x05\x4a" +
+	"\x0a\xd1\x28\x40\x0a\xd3\x42\x40\x0a\xd4\x42\x02\x0a\xd5\x43\x00\x0a\xdb\x43\x00\x0a\xdf\x28\x02\x0a\xe3\x46\x04\x0a\xe5\x26\x04" +
+	"\x0a\xee\x24\x40\x0a\xef\x43\x00\x0a\xf1\x42\x02\x0a\xf5\x05\x41\x0a\xf6\x43\x00\x0a\xf8\x42\x02\x0a\xf9\x05\x46\x0a\xff\x43\x00" +
+	"\x0b\x01\x05\x43\x0b\x03\x42\x42\x0b\x07\x42\x40\x0b\x08\x05\x40\x0b\x0b\x43\x02\x0b\x14\x2a\x08\x0b\x16\x08\x08\x0b\x17\x42\x08" +
+	"\x0b\x1c\x2a\x08\x0b\x1d\x42\x08\x0b\x1f\x42\x08\x0b\x21\x05\x48\x0b\x23\x26\x08\x0b\x29\x05\x48\x0b\x2e\x46\x40\x0b\x2f\x28\x40" +
+	"\x0b\x31\x47\x04\x0b\x33\x27\x00\x0b\x35\x42\x40\x0b\x37\x28\x41\x0b\x39\x27\x00\x0b\x3c\x43\x00\x0b\x3d\x46\x40\x0b\x3e\x24\x40" +
+	"\x0b\x41\x42\x40\x0b\x43\x05\x41\x0b\x44\x43\x40\x0b\x66\x09\x00\x0b\x6a\x2d\x00\x0b\x6c\x43\x00\x0b\x75\x05\x38\x0b\x76\x4b\x00" +
+	"\x0b\x78\x43\x00\x0b\x7b\x05\x38\x0b\x7c\x43\x00\x0b\x7d\x05\x38\x0b\x87\x42\x04\x0b\x93\x42\x02\x0b\x97\x42\x01\x0b\x9a\x09\x00" +
+	"\x0b\x9c\x43\x00\x0b\x9f\x05\x34\x0b\xa0\x43\x00\x0b\xa1\x29\x00\x0b\xa5\x05\x32\x0b\xa9\x05\x31\x0b\xab\x43\x10\x0b\xac\x43\x00" +
+	"\x0b\xad\x29\x00\x0b\xaf\x05\x31\x0b\xb1\x43\x10\x0b\xb3\x43\x00\x0b\xbd\x42\x04\x0b\xc9\x42\x02\x0b\xcd\x42\x01\x0b\xed\x05\x26" +
+	"\x0b\xf1\x05\x25\x0b\xf3\x43\x04\x0b\xfd\x05\x23\x0b\xff\x43\x02\x0c\x03\x43\x00\x0c\x04\x25\x00\x0c\x06\x2d\x00\x0c\x08\x43\x00" +
+	"\x0c\x0b\x43\x00\x0c\x0c\x43\x00\x0c\x0d\x05\x2c\x0c\x11\x43\x00\x0c\x15\x29\x00\x0c\x17\x43\x00\x0c\x18\x43\x00\x0c\x19\x05\x2a" +
+	"\x0c\x1b\x47\x00\x0c\x1d\x43\x00\x0c\x1f\x43\x08\x0c\x23\x43\x00\x0c\x27\x05\x25\x0c\x29\x43\x00\x0c\x32\x43\x00\x0c\x33\x05\x23" +
+	"\x0c\x35\x43\x00\x0c\x38\x43\x00\x0c\x39\x43\x01\x0c\x3c\x43\x00\x0c\x3d\x42\x20\x0c\x3f\x42\x20\x0c\x41\x43\x00\x0c\x43\x43\x00" +
+	"\x0c\x45\x42\x20\x0c\x47\x43\x00\x0c\x4a\x43\x20\x0c\x4b\x43\x00\x0c\x4c\x42\x20\x0c\x4f\x43\x00\x0c\x51\x43\x00\x0c\x52\x42\x20" +
+	"\x0c\x5f\x42\x04\x0c\x6b\x42\x02\x0c\x6f\x42\x01\x0c\x8f\x09\x00\x0c\x93\x29\x00\x0c\x95\x43\x00\x0c\x9e\x05\x10\x0c\x9f\x29\x00" +
+	"\x0c\xa1\x43\x00\x0c\xa4\x05\x10\x0c\xa5\x43\x00\x0c\xa6\x25\x00\x0c\xe6\x42\x04\x0c\xf2\x42\x02\x0c\xf6\x42\x01\x0c\xfb\x43\x00" +
+	"\x0c\xff\x29\x00\x0d\x01\x43\x00\x0d\x0a\x43\x00\x0d\x0b\x29\x00\x0d\x0d\x43\x00\x0d\x10\x43\x00\x0d\x11\x43\x00\x0d\x12\x05\x08" +
+	"\x0d\x1c\x43\x04\x0d\x28\x43\x02\x0d\x2c\x42\x01\x0d\x2f\x29\x00\x0d\x31\x43\x00\x0d\x34\x43\x00\x0d\x35\x43\x00\x0d\x36\x25\x00" +
+	"\x0d\x3a\x43\x00\x0d\x3e\x25\x00\x0d\x40\x43\x00\x0d\x41\x43\x00\x0d\x42\x25\x00\x0d\x44\x25\x00\x0d\x46\x43\x00\x0d\x48\x43\x00" +
+	"\x0d\x4a\x4b\x00\x0d\x4c\x43\x00\x0d\x4f\x05\x1c\x0d\x50\x43\x00\x0d\x51\x05\x1c\x0d\x55\x05\x1a\x0d\x59\x05\x19\x0d\x5b\x43\x10" +
+	"\x0d\x5c\x43\x00\x0d\x5d\x43\x00\x0d\x5f\x43\x00\x0d\x61\x43\x10\x0d\x63\x43\x08\x0d\x67\x47\x00\x0d\x6b\x05\x15\x0d\x6d\x43\x00" +
+	"\x0d\x76\x05\x10\x0d\x77\x43\x00\x0d\x79\x43\x00\x0d\x7c\x43\x00\x0d\x7d\x43\x01\x0d\x80\x43\x00\x0d\x81\x42\x10\x0d\x83\x42\x10" +
+	"\x0d\x85\x43\x10\x0d\x87\x43\x00\x0d\x89\x42\x10\x0d\x8b\x43\x10\x0d\x8e\x42\x10\x0d\x8f\x43\x10\x0d\x90\x42\x10\x0d\x93\x43\x00" +
+	"\x0d\x95\x43\x10\x0d\x96\x43\x10\x0d\x9d\x05\x0e\x0d\xa1\x05\x0d\x0d\xa3\x43\x04\x0d\xad\x43\x00\x0d\xaf\x43\x02\x0d\xb3\x43\x00" +
+	"\x0d\xb4\x43\x00\x0d\xbe\x42\x04\x0d\xca\x43\x02\x0d\xce\x43\x01\x0d\xd1\x05\x07\x0d\xd3\x43\x06\x0d\xd7\x43\x04\x0d\xd8\x05\x04" +
+	"\x0d\xe3\x43\x02\x0d\xe4\x43\x00\x0d\xea\x43\x00\x0d\xec\x43\x00\x0d\xed\x42\x08\x0d\xef\x42\x08\x0d\xf1\x43\x00\x0d\xf3\x43\x08" +
+	"\x0d\xf5\x42\x08\x0d\xf7\x43\x00\x0d\xfa\x43\x08\x0d\xfb\x43\x00\x0d\xfc\x42\x08\x0d\xff\x43\x08\x0e\x01\x43\x00\x0e\x02\x43\x08" +
+	"\x0e\x07\x05\x07\x0e\x09\x43\x00\x0e\x0c\x43\x00\x0e\x0d\x43\x01\x0e\x12\x43\x00\x0e\x16\x05\x01\x0e\x18\x43\x00\x0e\x19\x43\x01" +
+	"\x0e\x1c\x43\x00\x0e\x1e\x43\x00\x0e\x3d\x2e\x1d\x0e\x3e\x2c\x12\x0e\x40\x4a\x10\x0e\x42\x42\x10\x0e\x44\x2b\x3c\x0e\x46\x2c\x13" +
+	"\x0e\x48\x2a\x08\x0e\x4b\x42\x08\x0e\x4c\x2b\x39\x0e\x4d\x29\x30\x0e\x50\x46\x10\x0e\x52\x42\x10\x0e\x53\x42\x10\x0e\x58\x2c\x32" +
+	"\x0e\x5a\x46\x10\x0e\x5d\x46\x10\x0e\x5e\x2a\x35\x0e\x5f\x29\x30\x0e\x63\x28\x30\x0e\x67\x29\x30\x0e\x69\x46\x20\x0e\x6a\x46\x10" +
+	"\x0e\x6b\x42\x10\x0e\x6d\x42\x10\x0e\x6f\x42\x10\x0e\x71\x46\x10\x0e\x74\x46\x12\x0e\x76\x42\x10\x0e\x77\x05\x34\x0e\x7c\x4a\x01" +
+	"\x0e\x7d\x08\x02\x0e\x7f\x42\x01\x0e\x83\x47\x00\x0e\x89\x42\x10\x0e\x8e\x2c\x02\x0e\x90\x42\x02\x0e\x94\x2a\x2d\x0e\x95\x29\x00" +
+	"\x0e\x99\x42\x08\x0e\x9d\x29\x29\x0e\x9f\x26\x08\x0e\xa0\x42\x02\x0e\xa1\x05\x2a\x0e\xa7\x43\x00\x0e\xab\x05\x26\x0e\xaf\x28\x20" +
+	"\x0e\xb1\x42\x20\x0e\xba\x24\x20\x0e\xbb\x05\x23\x0e\xbd\x42\x22\x0e\xc1\x42\x20\x0e\xc2\x05\x20\x0e\xc4\x42\x02\x0e\xc5\x05\x26" +
+	"\x0e\xcb\x43\x00\x0e\xcd\x42\x01\x0e\xd3\x26\x01\x0e\xd4\x25\x00\x0e\xd7\x43\x02\x0e\xe0\x2a\x04\x0e\xe2\x08\x04\x0e\xe3\x42\x04" +
+	"\x0e\xe8\x2b\x29\x0e\xe9\x28\x02\x0e\xeb\x46\x01\x0e\xed\x05\x28\x0e\xef\x27\x28\x0e\xfa\x2a\x04\x0e\xfb\x42\x04\x0e\xfd\x42\x04" +
+	"\x0e\xff\x05\x24\x0f\x01\x26\x04\x0f\x03\x29\x23\x0f\x05\x27\x00\x0f\x08\x43\x00\x0f\x09\x27\x21\x0f\x0a\x25\x20\x0f\x19\x42\x04" +
+	"\x0f\x1f\x42\x02\x0f\x21\x42\x01\x0f\x30\x4a\x01\x0f\x32\x08\x08\x0f\x35\x42\x08\x0f\x36\x2a\x1d\x0f\x37\x29\x10\x0f\x3b\x42\x08" +
+	"\x0f\x3f\x29\x00\x0f\x41\x43\x00\x0f\x42\x46\x10\x0f\x43\x42\x10\x0f\x45\x42\x10\x0f\x47\x42\x10\x0f\x49\x46\x10\x0f\x4d\x46\x10" +
+	"\x0f\x51\x28\x10\x0f\x53\x42\x10\x0f\x5c\x05\x10\x0f\x5d\x42\x10\x0f\x5f\x42\x10\x0f\x62\x42\x10\x0f\x63\x42\x10\x0f\x64\x42\x10" +
+	"\x0f\x66\x4a\x01\x0f\x67\x09\x16\x0f\x69\x42\x01\x0f\x6d\x47\x00\x0f\x6f\x42\x01\x0f\x75\x43\x00\x0f\x76\x25\x00\x0f\x79\x46\x10" +
+	"\x0f\x7b\x42\x10\x0f\x7c\x42\x10\x0f\x83\x42\x08\x0f\x87\x28\x08\x0f\x89\x42\x08\x0f\x92\x42\x08\x0f\x93\x05\x0b\x0f\x95\x42\x0a" +
+	"\x0f\x99\x42\x08\x0f\x9a\x05\x08\x0f\xb7\x42\x01\x0f\xbd\x26\x01\x0f\xbe\x25\x00\x0f\xc6\x42\x01\x0f\xc9\x42\x02\x0f\xca\x05\x02" +
+	"\x0f\xd0\x43\x00\x0f\xd2\x2a\x04\x0f\xd3\x42\x04\x0f\xd5\x42\x04\x0f\xd7\x05\x0c\x0f\xd9\x26\x04\x0f\xdb\x29\x00\x0f\xdd\x43\x00" +
+	"\x0f\xe0\x43\x08\x0f\xe1\x43\x00\x0f\xe2\x25\x00\x0f\xed\x42\x04\x0f\xef\x05\x06\x0f\xf2\x43\x04\x0f\xf3\x26\x04\x0f\xf4\x42\x04" +
+	"\x0f\xf8\x43\x00\x0f\xfc\x25\x00\x0f\xfe\x43\x00\x10\x09\x46\x04\x10\x0b\x05\x05\x10\x0c\x42\x04\x10\x11\x43\x00\x10\x12\x05\x02" +
+	"\x10\x14\x42\x01\x10\x18\x43\x00\x10\x24\x46\x12\x10\x26\x42\x10\x10\x27\x05\x1c\x10\x2c\x46\x02\x10\x2d\x28\x02\x10\x2f\x05\x19" +
+	"\x10\x31\x42\x10\x10\x33\x27\x18\x10\x39\x42\x10\x10\x3e\x2b\x16\x10\x3f\x46\x04\x10\x41\x28\x10\x10\x43\x42\x10\x10\x45\x27\x14" +
+	"\x10\x47\x28\x13\x10\x49\x27\x12\x10\x4c\x24\x10\x10\x4d\x27\x11\x10\x4e\x25\x10\x10\x51\x05\x12\x10\x53\x42\x10\x10\x54\x43\x10" +
+	"\x10\x5d\x42\x14\x10\x63\x42\x02\x10\x65\x42\x11\x10\x74\x42\x02\x10\x75\x05\x0e\x10\x7b\x43\x00\x10\x7d\x28\x02\x10\x7f\x42\x02" +
+	"\x10\x83\x27\x09\x10\x84\x25\x00\x10\x87\x43\x02\x10\x8f\x28\x02\x10\x91\x42\x02\x10\x95\x27\x05\x10\x96\x25\x00\x10\x9a\x24\x02" +
+	"\x10\x9e\x25\x01\x10\xa0\x23\x00\x10\xa1\x42\x02\x10\xa2\x43\x00\x10\xa8\x43\x00\x10\xab\x43\x02\x10\xb3\x42\x02\x10\xb4\x05\x02" +
+	"\x10\xba\x43\x00\x10\xc9\x42\x04\x10\xcf\x42\x02\x10\xd1\x42\x01\x10\xe1\x26\x04\x10\xe3\x26\x04\x10\xe4\x42\x04\x10\xe9\x27\x03" +
+	"\x10\xea\x24\x02\x10\xec\x24\x01\x10\xee\x23\x00\x10\xf0\x23\x00\x11\x17\x4a\x54\x11\x19\x2f\x7d\x11\x1a\x2c\x04\x11\x1f\x4b\x11" +
+	"\x11\x20\x08\x02\x11\x22\x2c\x01\x11\x24\x2b\x40\x11\x26\x46\x10\x11\x2c\x2b\x40\x11\x31\x4a\x50\x11\x32\x4a\x40\x11\x34\x2c\x05" +
+	"\x11\x36\x2b\x70\x11\x38\x42\x40\x11\x3a\x2c\x10\x11\x3c\x46\x30\x11\x3f\x47\x00\x11\x40\x46\x10\x11\x41\x42\x10\x11\x44\x42\x40" +
+	"\x11\x46\x2b\x70\x11\x47\x46\x40\x11\x50\x42\x04\x11\x56\x42\x02\x11\x58\x42\x01\x11\x67\x4b\x6d\x11\x68\x09\x00\x11\x6a\x2d\x45" +
+	"\x11\x6c\x2b\x68\x11\x6e\x43\x00\x11\x70\x08\x40\x11\x72\x42\x40\x11\x75\x46\x40\x11\x76\x42\x40\x11\x77\x05\x68\x11\x7a\x43\x00" +
+	"\x11\x7c\x2b\x68\x11\x7d\x47\x00\x11\x82\x08\x20\x11\x84\x42\x20\x11\x87\x28\x20\x11\x88\x42\x20\x11\x89\x05\x64\x11\x8d\x05\x62" +
+	"\x11\x91\x05\x61\x11\x93\x42\x60\x11\x94\x42\x20\x11\x95\x05\x62\x11\x97\x28\x20\x11\x99\x42\x20\x11\x9b\x43\x60\x11\x9e\x43\x00" +
+	"\x11\xa0\x46\x05\x11\xa1\x05\x64\x11\xa6\x42\x40\x11\xa7\x05\x62\x11\xa9\x05\x61\x11\xab\x42\x40\x11\xad\x43\x40\x11\xb3\x43\x00" +
+	"\x11\xbc\x42\x04\x11\xc2\x42\x02\x11\xc4\x42\x01\x11\xd4\x42\x40\x11\xd6\x46\x01\x11\xd7\x05\x64\x11\xdc\x2a\x02\x11\xdd\x42\x02" +
+	"\x11\xdf\x28\x01\x11\xe1\x27\x00\x11\xe9\x42\x40\x12\x09\x4b\x10\x12\x0a\x2c\x10\x12\x0c\x2c\x05\x12\x0e\x2b\x58\x12\x10\x46\x10" +
+	"\x12\x12\x4b\x00\x12\x14\x43\x00\x12\x17\x47\x00\x12\x18\x43\x00\x12\x19\x42\x10\x12\x1c\x46\x18\x12\x1e\x2b\x58\x12\x1f\x46\x40" +
+	"\x12\x24\x4a\x10\x12\x26\x42\x10\x12\x29\x28\x10\x12\x2a\x42\x10\x12\x2b\x42\x10\x12\x2f\x05\x52\x12\x33\x42\x10\x12\x35\x43\x10" +
+	"\x12\x36\x42\x10\x12\x37\x05\x52\x12\x39\x28\x10\x12\x3b\x42\x10\x12\x3d\x42\x50\x12\x40\x47\x04\x12\x42\x46\x04\x12\x43\x28\x04" +
+	"\x12\x48\x43\x00\x12\x49\x05\x52\x12\x4b\x05\x51\x12\x4d\x43\x00\x12\x4f\x43\x00\x12\x55\x27\x50\x12\x5a\x08\x08\x12\x5c\x42\x08" +
+	"\x12\x5f\x28\x08\x12\x60\x42\x08\x12\x61\x05\x4c\x12\x65\x05\x4a\x12\x69\x05\x49\x12\x6b\x43\x48\x12\x6c\x42\x08\x12\x6d\x05\x4a" +
+	"\x12\x6f\x28\x08\x12\x71\x42\x08\x12\x73\x43\x08\x12\x90\x47\x04\x12\x91\x29\x00\x12\x93\x46\x40\x12\x95\x26\x40\x12\x97\x43\x00" +
+	"\x12\x99\x05\x43\x12\x9b\x43\x40\x12\x9e\x42\x40\x12\x9f\x43\x40\x12\xa0\x05\x40\x12\xa3\x43\x00\x12\xa5\x27\x41\x12\xa6\x25\x00" +
+	"\x12\xac\x2a\x02\x12\xae\x46\x04\x12\xaf\x28\x04\x12\xb4\x43\x00\x12\xb5\x42\x02\x12\xb7\x05\x49\x12\xb9\x43\x00\x12\xc1\x26\x40" +
+	"\x12\xc6\x08\x02\x12\xc7\x42\x02\x12\xc9\x47\x40\x12\xcb\x27\x00\x12\xcf\x42\x02\x12\xd1\x43\x00\x12\xd4\x43\x00\x12\xd9\x42\x40" +
+	"\x12\xdb\x26\x40\x12\xdc\x42\x40\x12\xe5\x42\x04\x12\xeb\x43\x02\x12\xed\x43\x01\x13\x00\x42\x04\x13\x06\x42\x02\x13\x08\x42\x01" +
+	"\x13\x18\x42\x40\x13\x1a\x46\x01\x13\x1b\x05\x54\x13\x20\x46\x41\x13\x21\x05\x52\x13\x23\x28\x01\x13\x25\x27\x50\x13\x27\x42\x40" +
+	"\x13\x2d\x42\x40\x13\x4e\x43\x00\x13\x50\x46\x05\x13\x51\x05\x4c\x13\x56\x42\x40\x13\x57\x05\x4a\x13\x59\x05\x49\x13\x5b\x42\x40" +
+	"\x13\x5d\x43\x40\x13\x63\x43\x00\x13\x68\x46\x41\x13\x69\x05\x46\x13\x6b\x47\x00\x13\x6d\x27\x44\x13\x6f\x43\x40\x13\x71\x28\x40" +
+	"\x13\x73\x42\x40\x13\x76\x24\x40\x13\x77\x42\x40\x13\x78\x05\x40\x13\x7b\x43\x40\x13\x7d\x27\x00\x13\x7e\x43\x00\x13\x87\x43\x04" +
+	"\x13\x8d\x43\x42\x13\x8f\x42\x41\x13\xbd\x42\x44\x13\xc3\x42\x42\x13\xc5\x42\x01\x13\xef\x4a\x19\x13\xf0\x08\x08\x13\xf2\x2c\x05" +
+	"\x13\xf4\x2b\x3c\x13\xf6\x42\x08\x13\xf8\x2c\x10\x13\xfa\x42\x10\x13\xfd\x28\x10\x13\xfe\x42\x10\x13\xff\x42\x10\x14\x02\x42\x08" +
+	"\x14\x04\x2b\x39\x14\x05\x28\x08\x14\x0a\x08\x01\x14\x0c\x46\x10\x14\x0f\x28\x34\x14\x10\x42\x01\x14\x15\x46\x10\x14\x19\x42\x10" +
+	"\x14\x1b\x42\x10\x14\x1c\x42\x01\x14\x1f\x28\x01\x14\x21\x27\x30\x14\x26\x46\x14\x14\x28\x46\x04\x14\x29\x28\x04\x14\x2e\x42\x10" +
+	"\x14\x2f\x05\x32\x14\x31\x05\x31\x14\x33\x42\x10\x14\x35\x42\x10\x14\x3b\x27\x30\x14\x40\x08\x04\x14\x42\x42\x04\x14\x45\x28\x04" +
+	"\x14\x46\x42\x04\x14\x47\x05\x2c\x14\x52\x46\x09\x14\x53\x05\x2a\x14\x55\x29\x29\x14\x57\x27\x28\x14\x59\x43\x08\x14\x5d\x05\x26" +
+	"\x14\x61\x05\x25\x14\x63\x42\x24\x14\x6d\x05\x23\x14\x6f\x42\x20\x14\x72\x24\x20\x14\x73\x42\x21\x14\x76\x42\x04\x14\x77\x05\x26" +
+	"\x14\x79\x28\x04\x14\x7b\x42\x04\x14\x7d\x43\x04\x14\x89\x43\x00\x14\x8b\x27\x21\x14\x8c\x25\x00\x14\x92\x42\x08\x14\x94\x46\x01" +
+	"\x14\x95\x05\x2c\x14\x9a\x2a\x02\x14\x9b\x42\x02\x14\x9d\x28\x01\x14\x9f\x27\x00\x14\xa7\x42\x08\x14\xac\x42\x01\x14\xaf\x28\x01" +
+	"\x14\xb1\x27\x00\x14\xb5\x42\x02\x14\xb7\x05\x22\x14\xba\x43\x00\x14\xc1\x42\x01\x14\xcb\x42\x04\x14\xd1\x42\x02\x14\xd3\x42\x01" +
+	"\x14\xe2\x2c\x10\x14\xe4\x46\x14\x14\xe7\x28\x14\x14\xe8\x46\x10\x14\xe9\x42\x10\x14\xed\x05\x1a\x14\xf1\x42\x10\x14\xf3\x43\x10" +
+	"\x14\xf4\x46\x08\x14\xf5\x28\x08\x14\xf7\x28\x19\x14\xf9\x27\x18\x14\xfb\x42\x08\x14\xff\x46\x10\x15\x03\x42\x10\x15\x05\x42\x10" +
+	"\x15\x0e\x42\x10\x15\x0f\x05\x13\x15\x11\x42\x10\x15\x14\x24\x10\x15\x15\x42\x11\x15\x18\x46\x04\x15\x19\x28\x14\x15\x1b\x28\x04" +
+	"\x15\x1d\x46\x04\x15\x1f\x46\x10\x15\x21\x05\x13\x15\x23\x43\x10\x15\x26\x05\x10\x15\x27\x43\x10\x15\x28\x42\x10\x15\x2b\x27\x12" +
+	"\x15\x2d\x27\x11\x15\x2e\x25\x10\x15\x35\x05\x0e\x15\x39\x05\x0d\x15\x3b\x42\x0c\x15\x45\x28\x08\x15\x47\x42\x08\x15\x4a\x24\x08" +
+	"\x15\x4b\x42\x08\x15\x4c\x05\x08\x15\x69\x28\x04\x15\x6b\x42\x04\x15\x6e\x24\x04\x15\x6f\x42\x04\x15\x70\x05\x04\x15\x7b\x27\x03" +
+	"\x15\x7c\x25\x00\x15\x7e\x25\x01\x15\x80\x23\x00\x15\x82\x43\x00\x15\x84\x2a\x02\x15\x85\x42\x02\x15\x87\x28\x05\x15\x89\x27\x00" +
+	"\x15\x8d\x42\x02\x15\x8f\x43\x00\x15\x92\x43\x00\x15\x97\x42\x08\x15\x99\x27\x09\x15\x9a\x24\x08\x15\x9f\x42\x02\x15\xa1\x05\x06" +
+	"\x15\xa4\x43\x00\x15\xaa\x43\x02\x15\xb1\x42\x01\x15\xb4\x24\x01\x15\xb6\x23\x00\x15\xbb\x26\x02\x15\xbd\x46\x04\x15\xbe\x24\x04" +
+	"\x15\xc3\x43\x00\x15\xc4\x42\x02\x15\xc6\x05\x01\x15\xc8\x43\x00\x15\xd0\x23\x00\x15\xd6\x42\x08\x15\xd8\x46\x05\x15\xd9\x05\x1c" +
+	"\x15\xde\x42\x10\x15\xdf\x05\x1a\x15\xe1\x05\x19\x15\xe3\x42\x10\x15\xe5\x42\x18\x15\xeb\x42\x08\x15\xf0\x42\x01\x15\xf3\x28\x01" +
+	"\x15\xf5\x27\x14\x15\xf9\x05\x13\x15\xfb\x42\x10\x15\xfe\x24\x10\x15\xff\x42\x11\x16\x05\x42\x01\x16\x0f\x42\x04\x16\x15\x42\x12" +
+	"\x16\x17\x42\x11\x16\x26\x42\x04\x16\x27\x05\x0e\x16\x29\x28\x04\x16\x2b\x42\x04\x16\x2d\x43\x0c\x16\x39\x43\x08\x16\x3b\x27\x00" +
+	"\x16\x3c\x43\x00\x16\x41\x05\x07\x16\x43\x42\x04\x16\x46\x24\x04\x16\x47\x42\x05\x16\x53\x42\x01\x16\x56\x43\x00\x16\x58\x23\x00" +
+	"\x16\x5d\x43\x04\x16\x5f\x42\x04\x16\x60\x05\x04\x16\x72\x43\x00\x16\x7b\x42\x0c\x16\x81\x42\x0a\x16\x83\x42\x01\x16\x95\x42\x01" +
+	"\x16\x9b\x42\x01\x16\x9e\x24\x01\x16\xa0\x23\x00\x16\xcc\x42\x04\x16\xd2\x42\x02\x16\xd4\x42\x01\x16\xe4\x09\x00\x16\xe6\x4b\x00" +
+	"\x16\xe7\x43\x00\x16\xec\x4b\x00\x16\xed\x43\x00\x16\xef\x43\x00\x16\xf1\x27\x00\x16\xf3\x05\x30\x16\xf9\x05\x30\x17\x1a\x43\x00" +
+	"\x17\x1c\x2b\x00\x17\x1d\x43\x00\x17\x22\x47\x00\x17\x23\x43\x00\x17\x25\x43\x00\x17\x27\x05\x28\x17\x29\x43\x00\x17\x2f\x43\x00" +
+	"\x17\x34\x42\x20\x17\x35\x43\x00\x17\x37\x43\x00\x17\x39\x42\x20\x17\x3b\x43\x20\x17\x3d\x43\x00\x17\x3f\x42\x20\x17\x42\x43\x00" +
+	"\x17\x43\x42\x20\x17\x44\x43\x00\x17\x47\x43\x20\x17\x49\x42\x20\x17\x4a\x43\x00\x17\x53\x43\x04\x17\x59\x43\x02\x17\x5b\x42\x01" +
+	"\x17\x89\x42\x04\x17\x8f\x42\x02\x17\x91\x42\x01\x17\xbc\x47\x00\x17\xbe\x4b\x00\x17\xbf\x43\x00\x17\xc4\x43\x00\x17\xc5\x43\x00" +
+	"\x17\xc7\x43\x00\x17\xc9\x43\x00\x17\xcb\x43\x00\x17\xd1\x05\x18\x17\xd6\x42\x10\x17\xd7\x43\x00\x17\xd9\x43\x00\x17\xdb\x42\x10" +
+	"\x17\xdd\x42\x10\x17\xdf\x43\x00\x17\xe1\x43\x10\x17\xe4\x43\x00\x17\xe5\x43\x10\x17\xe6\x43\x10\x17\xe9\x42\x10\x17\xeb\x42\x10" +
+	"\x17\xec\x43\x10\x17\xf5\x42\x04\x17\xfb\x43\x02\x17\xfd\x43\x01\x18\x0c\x42\x08\x18\x0d\x43\x00\x18\x0f\x43\x00\x18\x11\x42\x08" +
+	"\x18\x13\x43\x08\x18\x15\x43\x00\x18\x17\x43\x08\x18\x1a\x43\x08\x18\x1b\x43\x08\x18\x1c\x43\x00\x18\x1f\x43\x08\x18\x21\x42\x08" +
+	"\x18\x22\x43\x00\x18\x43\x43\x00\x18\x45\x05\x05\x18\x46\x43\x00\x18\x4b\x43\x00\x18\x4c\x43\x00\x18\x4e\x43\x01\x18\x52\x43\x00" +
+	"\x18\x58\x43\x00\x18\x61\x42\x04\x18\x67\x43\x02\x18\x69\x43\x01\x18\x79\x05\x06\x18\x7b\x05\x05\x18\x7c\x43\x04\x18\x81\x43\x00" +
+	"\x18\x82\x43\x02\x18\x84\x43\x00\x18\x86\x43\x00\x18\xcd\x42\x04\x18\xd3\x42\x02\x18\xd5\x42\x01\x19\x03\x43\x04\x19\x09\x43\x02" +
+	"\x19\x0b\x42\x01\x19\x1b\x43\x00\x19\x1d\x27\x00\x19\x1e\x43\x00\x19\x23\x27\x00\x19\x24\x43\x00\x19\x26\x43\x00\x19\x28\x23\x00" +
+	"\x19\x2a\x43\x00\x19\x30\x43\x00\x19\xa1\x30\x10\x19\xa3\x4a\x44\x19\xa6\x2c\xd0\x19\xa7\x4a\x54\x19\xa8\x2c\x10\x19\xac\x08\x20" +
+	"\x19\xb0\x08\x20\x19\xb2\x42\x20\x19\xb3\x4a\xc1\x19\xb4\x08\x10\x19\xb6\x2c\x90\x19\xb8\x46\x80\x19\xba\x42\x10\x19\xbe\x2c\x34" +
+	"\x19\xc2\x2c\x10\x19\xc4\x4a\x04\x19\xcd\x08\x20\x19\xce\x2c\x01\x19\xd0\x2a\x02\x19\xd3\x28\x90\x19\xd4\x46\x01\x19\xd5\x04\xf0" +
+	"\x19\xd7\x4a\x54\x19\xd8\x2c\x10\x19\xda\x2c\x10\x19\xdc\x4a\x40\x19\xde\x42\x10\x19\xe0\x08\x20\x19\xe2\x42\x20\x19\xe5\x42\x40" +
+	"\x19\xe6\x42\x20\x19\xe7\x04\xf0\x19\xea\x42\x10\x19\xec\x46\x90\x19\xed\x46\x10\x19\xf4\x2c\x44\x19\xf8\x08\x01\x19\xfa\x46\x04" +
+	"\x1a\x03\x04\xe8\x1a\x04\x2c\x01\x1a\x06\x2a\x02\x1a\x09\x28\x80\x1a\x0a\x42\x01\x1a\x15\x42\x04\x1a\x21\x42\x02\x1a\x25\x42\x01" +
+	"\x1a\x28\x08\x01\x1a\x2a\x46\x40\x1a\x2d\x42\x40\x1a\x2e\x42\x01\x1a\x33\x42\x40\x1a\x37\x04\xe1\x1a\x39\x42\x60\x1a\x3a\x42\x01" +
+	"\x1a\x3d\x04\xe1\x1a\x3f\x42\x80\x1a\x43\x2e\xef\x1a\x44\x2c\xaa\x1a\x46\x2c\x2d\x1a\x48\x2a\x6c\x1a\x4a\x2a\x80\x1a\x4c\x2c\x20" +
+	"\x1a\x4e\x42\x20\x1a\x51\x28\x20\x1a\x52\x42\x20\x1a\x53\x42\x80\x1a\x56\x46\x40\x1a\x58\x2a\x40\x1a\x59\x42\x40\x1a\x5e\x2c\xc3" +
+	"\x1a\x60\x2a\xc6\x1a\x63\x28\xe4\x1a\x64\x2a\x80\x1a\x65\x42\x80\x1a\x69\x28\x20\x1a\x6d\x42\x80\x1a\x6f\x42\x20\x1a\x70\x46\x40" +
+	"\x1a\x71\x42\x40\x1a\x73\x42\x40\x1a\x75\x26\x40\x1a\x77\x42\x40\x1a\x7a\x2a\x20\x1a\x7c\x2a\x20\x1a\x7d\x42\x20\x1a\x82\x42\x20" +
+	"\x1a\x83\x42\x20\x1a\x85\x42\x20\x1a\x87\x42\x20\x1a\x89\x42\x20\x1a\x8f\x04\xe0\x1a\x96\x4a\x04\x1a\x9a\x4a\x04\x1a\x9c\x42\x04" +
+	"\x1a\xa6\x4a\x03\x1a\xa8\x08\x02\x1a\xab\x46\x40\x1a\xac\x08\x01\x1a\xad\x46\x10\x1a\xb7\x42\x04\x1a\xc3\x42\x02\x1a\xc7\x42\x01" +
+	"\x1a\xca\x2c\x04\x1a\xcc\x42\x04\x1a\xcf\x42\x40\x1a\xd0\x42\x04\x1a\xd1\x04\xd4\x1a\xdc\x2a\x41\x1a\xdd\x28\x10\x1a\xdf\x46\x40" +
+	"\x1a\xe1\x26\x40\x1a\xe3\x42\x10\x1a\xed\x42\x04\x1a\xf9\x42\x02\x1a\xfd\x42\x01\x1b\x1d\x42\x40\x1b\x21\x04\xc5\x1b\x23\x42\x04" +
+	"\x1b\x2d\x46\x01\x1b\x2f\x04\xc2\x1b\x32\x42\x40\x1b\x33\x42\x01\x1b\x36\x2c\x04\x1b\x38\x42\x04\x1b\x3b\x28\x04\x1b\x3c\x42\x04" +
+	"\x1b\x3d\x42\x80\x1b\x48\x2a\x40\x1b\x49\x42\x40\x1b\x4b\x42\x40\x1b\x4d\x26\x40\x1b\x4f\x04\xc8\x1b\x53\x46\x04\x1b\x57\x42\x80" +
+	"\x1b\x59\x42\x04\x1b\x63\x42\x40\x1b\x65\x04\xc2\x1b\x68\x42\x40\x1b\x69\x04\xc1\x1b\x6a\x42\xc0\x1b\x6c\x42\x04\x1b\x6d\x28\x04" +
+	"\x1b\x6f\x28\x04\x1b\x71\x42\x04\x1b\x73\x42\x04\x1b\x7f\x26\x40\x1b\x81\x26\x40\x1b\x82\x42\x40\x1b\x87\x4a\xd0\x1b\x88\x2c\x10" +
+	"\x1b\x8a\x2c\x10\x1b\x8c\x46\x80\x1b\x8e\x42\x10\x1b\x90\x2c\x10\x1b\x92\x46\x40\x1b\x95\x46\x08\x1b\x96\x46\x50\x1b\x97\x46\x10" +
+	"\x1b\x9a\x42\x10\x1b\x9c\x46\x90\x1b\x9d\x46\x10\x1b\xa2\x2c\x41\x1b\xa4\x2a\xd6\x1b\xa7\x28\xd0\x1b\xa8\x46\x41\x1b\xa9\x04\xd4" +
+	"\x1b\xad\x28\xd0\x1b\xb1\x28\x50\x1b\xb3\x46\x40\x1b\xb4\x46\x41\x1b\xb5\x04\xd2\x1b\xb7\x08\xd1\x1b\xb9\x46\x80\x1b\xbb\x42\x50" +
+	"\x1b\xbe\x42\x10\x1b\xc0\x46\x10\x1b\xc1\x42\x10\x1b\xc6\x46\x10\x1b\xc7\x42\x10\x1b\xc9\x42\x10\x1b\xcb\x04\xd0\x1b\xcd\x42\x10" +
+	"\x1b\xd3\x42\x10\x1b\xd8\x08\x01\x1b\xda\x46\xc0\x1b\xdd\x28\x80\x1b\xde\x42\x01\x1b\xe3\x28\x40\x1b\xe7\x04\xc9\x1b\xe9\x42\x40" +
+	"\x1b\xea\x42\x01\x1b\xed\x04\xc9\x1b\xef\x42\x80\x1b\xf5\x28\xc6\x1b\xf9\x28\x01\x1b\xfb\x26\x04\x1c\x04\x24\x40\x1c\x05\x28\x01" +
+	"\x1c\x07\x26\x02\x1c\x0a\x24\x80\x1c\x0b\x42\x01\x1c\x0e\x42\x01\x1c\x11\x04\xc5\x1c\x13\x42\x80\x1c\x17\x04\xc3\x1c\x19\x42\x40" +
+	"\x1c\x1c\x42\x40\x1c\x1d\x42\x41\x1c\x23\x42\x81\x1c\x2a\x2a\x08\x1c\x2c\x2a\x08\x1c\x2d\x42\x08\x1c\x32\x2a\x08\x1c\x33\x42\x08" +
+	"\x1c\x35\x42\x08\x1c\x37\x26\x08\x1c\x39\x04\xc8\x1c\x3f\x04\xc8\x1c\x44\x46\x40\x1c\x45\x28\x40\x1c\x47\x28\x41\x1c\x49\x26\xc4" +
+	"\x1c\x4b\x42\x40\x1c\x4d\x28\xc3\x1c\x4f\x26\xc2\x1c\x52\x24\xc0\x1c\x53\x26\x80\x1c\x54\x42\x80\x1c\x57\x42\x40\x1c\x59\x46\x40" +
+	"\x1c\x5a\x42\x40\x1c\x7c\x08\x80\x1c\x80\x08\x80\x1c\x82\x42\x80\x1c\x8b\x04\xb8\x1c\x8c\x08\x80\x1c\x8e\x42\x80\x1c\x91\x28\x80" +
+	"\x1c\x92\x42\x80\x1c\x93\x04\xb8\x1c\x9d\x42\x04\x1c\xa9\x42\x02\x1c\xad\x42\x01\x1c\xb0\x08\x80\x1c\xb2\x42\x80\x1c\xb5\x08\x80" +
+	"\x1c\xb6\x42\x80\x1c\xb7\x04\xb4\x1c\xbb\x04\xb2\x1c\xbf\x04\xb1\x1c\xc1\x42\xb0\x1c\xc2\x42\x80\x1c\xc3\x04\xb2\x1c\xc5\x28\x80" +
+	"\x1c\xc7\x42\x80\x1c\xc9\x42\x90\x1c\xd3\x42\x04\x1c\xdf\x42\x02\x1c\xe3\x42\x01\x1d\x03\x04\xa6\x1d\x07\x04\xa5\x1d\x09\x42\x84" +
+	"\x1d\x13\x04\xa3\x1d\x15\x42\x80\x1d\x18\x24\x80\x1d\x19\x42\x81\x1d\x1c\x2c\x80\x1d\x1e\x42\x80\x1d\x21\x28\x80\x1d\x22\x42\x80" +
+	"\x1d\x23\x42\x80\x1d\x27\x04\xaa\x1d\x2b\x42\x80\x1d\x2d\x42\xa0\x1d\x2e\x42\x80\x1d\x2f\x04\xaa\x1d\x31\x28\x80\x1d\x33\x42\x80" +
+	"\x1d\x35\x42\x88\x1d\x39\x28\x80\x1d\x3d\x42\x80\x1d\x3f\x42\x80\x1d\x48\x42\x80\x1d\x49\x04\xa3\x1d\x4b\x42\x80\x1d\x4e\x24\x80" +
+	"\x1d\x4f\x42\x81\x1d\x52\x42\x80\x1d\x53\x42\x20\x1d\x55\x42\x20\x1d\x57\x42\x80\x1d\x59\x42\x80\x1d\x5b\x42\x20\x1d\x5d\x42\xa0" +
+	"\x1d\x60\x42\x20\x1d\x61\x42\xa0\x1d\x62\x42\xa0\x1d\x65\x42\x80\x1d\x67\x42\x80\x1d\x68\x42\x20\x1d\x75\x42\x04\x1d\x81\x42\x02" +
+	"\x1d\x85\x42\x01\x1d\xa5\x04\x96\x1d\xa9\x04\x95\x1d\xab\x42\x84\x1d\xb5\x28\x80\x1d\xb7\x42\x80\x1d\xba\x24\x80\x1d\xbb\x42\x80" +
+	"\x1d\xbc\x04\x90\x1d\xfc\x42\x04\x1e\x08\x42\x02\x1e\x0c\x42\x01\x1e\x11\x04\x8e\x1e\x15\x42\x80\x1e\x17\x42\x84\x1e\x21\x28\x80" +
+	"\x1e\x23\x42\x80\x1e\x26\x24\x80\x1e\x27\x42\x80\x1e\x28\x42\x80\x1e\x32\x42\x84\x1e\x3e\x42\x02\x1e\x42\x42\x81\x1e\x45\x04\x87" +
+	"\x1e\x47\x42\x84\x1e\x4a\x04\x84\x1e\x4b\x42\x84\x1e\x4c\x42\x80\x1e\x57\x42\x80\x1e\x58\x24\x80\x1e\x5a\x24\x80\x1e\x5c\x42\x80" +
+	"\x1e\x5e\x42\x80\x1e\x60\x08\x80\x1e\x62\x42\x80\x1e\x65\x28\x80\x1e\x66\x42\x80\x1e\x67\x04\x9c\x1e\x6b\x04\x9a\x1e\x6f\x04\x99" +
+	"\x1e\x71\x42\x90\x1e\x72\x42\x80\x1e\x73\x04\x9a\x1e\x75\x08\x80\x1e\x77\x42\x80\x1e\x79\x42\x98\x1e\x7d\x28\x80\x1e\x81\x04\x95" +
+	"\x1e\x83\x42\x80\x1e\x8c\x04\x90\x1e\x8d\x04\x93\x1e\x8f\x42\x80\x1e\x92\x24\x80\x1e\x93\x42\x81\x1e\x96\x42\x80\x1e\x97\x42\x10" +
+	"\x1e\x99\x42\x10\x1e\x9b\x42\x80\x1e\x9d\x42\x90\x1e\x9f\x42\x10\x1e\xa1\x42\x90\x1e\xa4\x42\x10\x1e\xa5\x42\x90\x1e\xa6\x42\x10" +
+	"\x1e\xa9\x42\x90\x1e\xab\x42\x80\x1e\xac\x42\x10\x1e\xb3\x04\x8e\x1e\xb7\x04\x8d\x1e\xb9\x42\x84\x1e\xc3\x04\x8b\x1e\xc5\x42\x80" +
+	"\x1e\xc8\x24\x80\x1e\xc9\x42\x81\x1e\xd4\x42\x04\x1e\xe0\x42\x02\x1e\xe4\x42\x01\x1e\xe7\x04\x87\x1e\xe9\x42\x84\x1e\xec\x04\x84" +
+	"\x1e\xed\x42\x85\x1e\xf9\x42\x81\x1e\xfc\x04\x81\x1e\xfe\x42\x80\x1f\x02\x42\x80\x1f\x03\x42\x08\x1f\x05\x42\x08\x1f\x07\x42\x80" +
+	"\x1f\x09\x42\x88\x1f\x0b\x42\x08\x1f\x0d\x42\x80\x1f\x10\x42\x08\x1f\x11\x42\x80\x1f\x12\x42\x88\x1f\x15\x42\x88\x1f\x17\x42\x80" +
+	"\x1f\x18\x42\x08\x1f\x1d\x04\x87\x1f\x1f\x42\x80\x1f\x22\x24\x80\x1f\x23\x42\x81\x1f\x28\x24\x80\x1f\x2c\x42\x80\x1f\x2e\x42\x80" +
+	"\x1f\x2f\x42\x81\x1f\x32\x04\x81\x1f\x34\x42\x80\x1f\x53\x4a\x25\x1f\x54\x08\x10\x1f\x56\x2c\x01\x1f\x58\x2a\x08\x1f\x5a\x42\x10" +
+	"\x1f\x5c\x08\x20\x1f\x5e\x42\x20\x1f\x61\x42\x08\x1f\x62\x42\x20\x1f\x63\x04\xb8\x1f\x66\x42\x10\x1f\x68\x46\x10\x1f\x69\x42\x10" +
+	"\x1f\x6e\x2c\x30\x1f\x70\x46\x20\x1f\x73\x28\x30\x1f\x74\x46\x30\x1f\x75\x46\x10\x1f\x79\x28\x20\x1f\x7d\x28\x20\x1f\x7f\x42\x20" +
+	"\x1f\x80\x46\x10\x1f\x81\x42\x10\x1f\x83\x42\x10\x1f\x85\x46\x10\x1f\x87\x42\x10\x1f\x8a\x42\x10\x1f\x8c\x46\x01\x1f\x8d\x04\xb4" +
+	"\x1f\x92\x42\x20\x1f\x93\x04\xb2\x1f\x95\x42\x01\x1f\x99\x42\x30\x1f\x9f\x42\x10\x1f\xa4\x2c\x01\x1f\xa6\x2a\x08\x1f\xa9\x42\x08" +
+	"\x1f\xaa\x42\x01\x1f\xaf\x42\x08\x1f\xb3\x04\xa9\x1f\xb5\x42\x20\x1f\xb6\x42\x01\x1f\xb9\x04\xa9\x1f\xbb\x42\x80\x1f\xc1\x28\x20" +
+	"\x1f\xc5\x04\xa5\x1f\xc7\x42\x20\x1f\xd0\x24\x20\x1f\xd1\x04\xa3\x1f\xd3\x42\x20\x1f\xd6\x04\xa0\x1f\xd7\x42\x21\x1f\xda\x42\x01" +
+	"\x1f\xdd\x42\x01\x1f\xe3\x42\x01\x1f\xe9\x42\x21\x1f\xef\x42\x81\x1f\xf6\x46\x04\x1f\xf8\x46\x04\x1f\xf9\x42\x04\x1f\xfe\x42\x20" +
+	"\x1f\xff\x04\xaa\x20\x01\x04\xa9\x20\x03\x42\x20\x20\x05\x42\x20\x20\x10\x2a\x04\x20\x11\x42\x04\x20\x13\x42\x04\x20\x15\x26\x04" +
+	"\x20\x17\x04\xa4\x20\x19\x28\x20\x20\x1b\x42\x20\x20\x1e\x24\x20\x20\x1f\x42\x20\x20\x20\x42\x80\x20\x2f\x42\x04\x20\x35\x42\x22" +
+	"\x20\x37\x42\x21\x20\x46\x08\x04\x20\x48\x42\x04\x20\x4b\x42\x08\x20\x4c\x42\x04\x20\x4d\x04\x9c\x20\x58\x46\x10\x20\x59\x42\x10" +
+	"\x20\x5b\x42\x10\x20\x5d\x04\x98\x20\x5f\x42\x10\x20\x63\x04\x96\x20\x67\x04\x95\x20\x69\x42\x14\x20\x73\x42\x10\x20\x75\x42\x10" +
+	"\x20\x78\x42\x10\x20\x79\x42\x10\x20\x7a\x42\x10\x20\x7c\x42\x04\x20\x7d\x04\x96\x20\x7f\x42\x01\x20\x83\x42\x14\x20\x8f\x42\x10" +
+	"\x20\x91\x04\x91\x20\x92\x42\x10\x20\x99\x42\x08\x20\x9d\x04\x8d\x20\x9f\x42\x0c\x20\xa9\x04\x8b\x20\xab\x42\x08\x20\xae\x42\x08" +
+	"\x20\xaf\x42\x09\x20\xcd\x42\x01\x20\xd3\x42\x05\x20\xdf\x42\x01\x20\xe2\x42\x01\x20\xe8\x42\x04\x20\xe9\x42\x04\x20\xeb\x42\x04" +
+	"\x20\xed\x42\x04\x20\xef\x42\x04\x21\x03\x42\x04\x21\x05\x42\x04\x21\x08\x42\x04\x21\x09\x42\x04\x21\x0a\x42\x84\x21\x1f\x42\x04" +
+	"\x21\x21\x42\x04\x21\x22\x42\x04\x21\x3a\x42\x10\x21\x3c\x46\x11\x21\x3d\x04\x9c\x21\x42\x46\x01\x21\x43\x04\x9a\x21\x45\x28\x01" +
+	"\x21\x47\x26\x08\x21\x49\x42\x10\x21\x4f\x42\x10\x21\x54\x2a\x97\x21\x55\x28\x10\x21\x57\x28\x11\x21\x59\x26\x94\x21\x5b\x42\x10" +
+	"\x21\x5d\x28\x13\x21\x5f\x26\x92\x21\x62\x24\x90\x21\x63\x26\x91\x21\x64\x24\x10\x21\x67\x42\x10\x21\x69\x46\x10\x21\x6a\x42\x10" +
+	"\x21\x73\x42\x14\x21\x79\x42\x12\x21\x7b\x42\x01\x21\x8a\x42\x01\x21\x8d\x04\x8d\x21\x8f\x42\x80\x21\x93\x28\x01\x21\x95\x26\x08" +
+	"\x21\x98\x42\x08\x21\x99\x42\x01\x21\x9f\x42\x81\x21\xa5\x28\x01\x21\xa7\x26\x86\x21\xaa\x24\x80\x21\xab\x42\x01\x21\xb0\x24\x82" +
+	"\x21\xb4\x24\x01\x21\xb6\x22\x80\x21\xb7\x42\x01\x21\xba\x04\x81\x21\xbc\x42\x80\x21\xc3\x42\x81\x21\xc9\x42\x01\x21\xcc\x42\x01" +
+	"\x21\xdf\x42\x04\x21\xe5\x42\x02\x21\xe7\x42\x01\x21\xf7\x26\x04\x21\xf9\x26\x04\x21\xfa\x42\x04\x21\xff\x26\x83\x22\x00\x24\x02" +
+	"\x22\x02\x24\x01\x22\x04\x22\x80\x22\x06\x22\x80\x22\x2e\x4a\x40\x22\x32\x2c\x40\x22\x34\x42\x40\x22\x3d\x04\x78\x22\x3e\x08\x40" +
+	"\x22\x40\x42\x40\x22\x43\x04\x78\x22\x44\x42\x40\x22\x45\x04\x78\x22\x4f\x42\x04\x22\x5b\x42\x02\x22\x5f\x42\x01\x22\x62\x4a\x40" +
+	"\x22\x64\x42\x40\x22\x67\x42\x40\x22\x68\x42\x40\x22\x69\x04\x74\x22\x6d\x42\x40\x22\x71\x04\x71\x22\x73\x42\x60\x22\x74\x42\x40" +
+	"\x22\x75\x04\x72\x22\x77\x04\x71\x22\x79\x42\x50\x22\x7b\x42\x50\x22\x85\x42\x04\x22\x91\x42\x02\x22\x95\x42\x01\x22\xb5\x42\x40" +
+	"\x22\xb9\x04\x65\x22\xbb\x42\x40\x22\xc4\x42\x40\x22\xc5\x04\x63\x22\xc7\x42\x42\x22\xcb\x42\x41\x22\xce\x2c\x40\x22\xd0\x42\x40" +
+	"\x22\xd3\x46\x40\x22\xd4\x42\x40\x22\xd5\x28\x40\x22\xd9\x04\x6a\x22\xdd\x04\x69\x22\xdf\x42\x60\x22\xe0\x42\x40\x22\xe1\x42\x40" +
+	"\x22\xe3\x42\x40\x22\xe5\x42\x40\x22\xe7\x42\x40\x22\xeb\x28\x40\x22\xef\x28\x40\x22\xf1\x42\x40\x22\xfa\x04\x60\x22\xfb\x42\x40" +
+	"\x22\xfd\x42\x40\x23\x00\x42\x40\x23\x01\x42\x40\x23\x02\x42\x40\x23\x04\x42\x40\x23\x05\x42\x20\x23\x07\x42\x20\x23\x09\x42\x40" +
+	"\x23\x0b\x42\x40\x23\x0d\x42\x20\x23\x0f\x42\x60\x23\x12\x42\x60\x23\x13\x42\x60\x23\x14\x42\x20\x23\x17\x42\x40\x23\x19\x42\x40" +
+	"\x23\x1a\x42\x60\x23\x27\x42\x04\x23\x33\x42\x02\x23\x37\x42\x01\x23\x57\x42\x40\x23\x5b\x04\x55\x23\x5d\x42\x44\x23\x67\x46\x40" +
+	"\x23\x69\x42\x40\x23\x6c\x42\x40\x23\x6d\x42\x40\x23\x6e\x04\x50\x23\xae\x42\x44\x23\xba\x42\x42\x23\xbe\x42\x01\x23\xc3\x04\x4e" +
+	"\x23\xc7\x04\x4d\x23\xc9\x42\x44\x23\xd3\x42\x40\x23\xd5\x42\x40\x23\xd8\x42\x40\x23\xd9\x42\x40\x23\xda\x42\x40\x23\xe4\x42\x04" +
+	"\x23\xf0\x42\x42\x23\xf4\x42\x41\x23\xf7\x04\x47\x23\xf9\x42\x44\x23\xfc\x42\x40\x23\xfd\x42\x44\x23\xfe\x04\x44\x24\x09\x42\x40" +
+	"\x24\x0a\x42\x40\x24\x0c\x42\x40\x24\x0e\x42\x40\x24\x10\x42\x40\x24\x12\x08\x40\x24\x14\x42\x40\x24\x17\x04\x5c\x24\x18\x42\x40" +
+	"\x24\x19\x04\x5c\x24\x1d\x28\x40\x24\x21\x28\x40\x24\x23\x42\x40\x24\x24\x42\x40\x24\x25\x04\x5a\x24\x27\x04\x59\x24\x29\x42\x50" +
+	"\x24\x2b\x42\x50\x24\x2f\x28\x40\x24\x33\x28\x40\x24\x35\x42\x40\x24\x3e\x24\x40\x24\x3f\x08\x40\x24\x41\x42\x40\x24\x44\x04\x50" +
+	"\x24\x45\x42\x40\x24\x46\x04\x50\x24\x48\x42\x40\x24\x49\x42\x10\x24\x4b\x42\x10\x24\x4d\x42\x50\x24\x4f\x42\x50\x24\x51\x42\x10" +
+	"\x24\x53\x42\x40\x24\x56\x42\x50\x24\x57\x42\x40\x24\x58\x42\x10\x24\x5b\x42\x50\x24\x5d\x42\x50\x24\x5e\x42\x10\x24\x65\x04\x4e" +
+	"\x24\x69\x04\x4d\x24\x6b\x42\x40\x24\x74\x24\x40\x24\x75\x04\x4b\x24\x77\x42\x42\x24\x7b\x42\x41\x24\x86\x42\x04\x24\x92\x42\x02" +
+	"\x24\x96\x42\x01\x24\x99\x04\x47\x24\x9b\x42\x42\x24\x9f\x42\x41\x24\xa4\x42\x40\x24\xa8\x04\x41\x24\xaa\x42\x40\x24\xab\x42\x43" +
+	"\x24\xb4\x42\x40\x24\xb5\x42\x08\x24\xb7\x42\x08\x24\xb9\x42\x40\x24\xbb\x42\x40\x24\xbd\x42\x08\x24\xbf\x42\x40\x24\xc2\x42\x08" +
+	"\x24\xc3\x42\x40\x24\xc4\x42\x08\x24\xc7\x42\x40\x24\xc9\x42\x40\x24\xca\x42\x48\x24\xcf\x28\x40\x24\xd1\x42\x40\x24\xd4\x24\x40" +
+	"\x24\xd5\x42\x40\x24\xd6\x24\x40\x24\xda\x24\x40\x24\xde\x24\x40\x24\xe0\x42\x40\x24\xe1\x42\x40\x24\xe2\x42\x40\x24\xe4\x42\x40" +
+	"\x24\xe6\x42\x40\x24\xe8\x42\x40\x27\xde\x4a\x09\x27\xe0\x08\x08\x27\xe3\x42\x08\x27\xe4\x2a\x05\x27\xe5\x28\x10\x27\xe9\x42\x08" +
+	"\x27\xed\x28\x20\x27\xef\x42\x20\x27\xf0\x46\x10\x27\xf1\x42\x10\x27\xf3\x42\x10\x27\xf5\x42\x10\x27\xf7\x42\x10\x27\xfb\x46\x04" +
+	"\x27\xff\x46\x04\x28\x01\x26\x04\x28\x0a\x24\x20\x28\x0b\x42\x10\x28\x0d\x04\x32\x28\x10\x42\x10\x28\x11\x04\x31\x28\x12\x42\x10" +
+	"\x28\x14\x08\x01\x28\x15\x46\x10\x28\x17\x42\x01\x28\x1b\x42\x10\x28\x1d\x42\x01\x28\x23\x42\x20\x28\x24\x04\x30\x28\x27\x42\x10" +
+	"\x28\x29\x42\x10\x28\x2a\x42\x10\x28\x31\x42\x08\x28\x35\x46\x01\x28\x37\x04\x2c\x28\x40\x42\x08\x28\x41\x04\x2b\x28\x43\x42\x02" +
+	"\x28\x47\x42\x01\x28\x52\x42\x04\x28\x5e\x42\x02\x28\x62\x42\x01\x28\x65\x42\x01\x28\x6b\x42\x01\x28\x74\x42\x01\x28\x77\x42\x03" +
+	"\x28\x80\x2a\x04\x28\x81\x42\x04\x28\x83\x42\x04\x28\x85\x04\x2c\x28\x87\x26\x04\x28\x89\x28\x20\x28\x8b\x42\x20\x28\x8e\x42\x08" +
+	"\x28\x8f\x42\x20\x28\x90\x24\x20\x28\x9b\x42\x04\x28\x9d\x26\x04\x28\xa0\x42\x04\x28\xa1\x26\x04\x28\xa2\x42\x04\x28\xa6\x24\x20" +
+	"\x28\xaa\x24\x20\x28\xac\x42\x20\x28\xb7\x04\x26\x28\xb9\x04\x25\x28\xba\x42\x24\x28\xbf\x42\x20\x28\xc0\x42\x20\x28\xc2\x42\x21" +
+	"\x28\xc6\x42\x20\x28\xd3\x42\x08\x28\xd7\x46\x04\x28\xd9\x42\x04\x28\xe3\x42\x10\x28\xe5\x04\x1a\x28\xe8\x42\x18\x28\xe9\x04\x19" +
+	"\x28\xea\x42\x10\x28\xf4\x42\x04\x29\x00\x42\x12\x29\x04\x42\x11\x29\x07\x42\x01\x29\x0d\x42\x04\x29\x0e\x04\x14\x29\x19\x04\x13" +
+	"\x29\x1a\x42\x10\x29\x1c\x42\x11\x29\x20\x42\x10\x29\x2a\x42\x0c\x29\x36\x42\x0a\x29\x3a\x42\x01\x29\x5e\x42\x01\x29\x6a\x42\x01" +
+	"\x29\x70\x42\x01\x29\x73\x42\x04\x29\x75\x42\x04\x29\x78\x42\x0c\x29\x79\x42\x04\x29\x7a\x42\x04\x29\x90\x42\x04\x29\x94\x42\x04" +
+	"\x29\x96\x42\x04\x29\xa9\x42\x04\x29\xaa\x42\x04\x29\xac\x42\x05\x29\xb0\x42\x04\x29\xc4\x46\x10\x29\xc5\x46\x10\x29\xc7\x46\x10" +
+	"\x29\xc9\x42\x10\x29\xcb\x42\x10\x29\xcd\x46\x08\x29\xcf\x26\x08\x29\xd2\x42\x08\x29\xd3\x26\x19\x29\xd4\x24\x10\x29\xd7\x42\x10" +
+	"\x29\xd9\x42\x10\x29\xda\x42\x10\x29\xdf\x28\x13\x29\xe1\x26\x16\x29\xe4\x24\x10\x29\xe5\x26\x15\x29\xe6\x24\x10\x29\xea\x24\x12" +
+	"\x29\xee\x24\x11\x29\xf0\x22\x10\x29\xf1\x46\x10\x29\xf2\x42\x10\x29\xf4\x42\x10\x29\xf6\x42\x10\x29\xf8\x42\x10\x29\xfb\x42\x10" +
+	"\x29\xfd\x42\x10\x29\xfe\x42\x10\x2a\x03\x04\x13\x2a\x04\x42\x10\x2a\x06\x42\x11\x2a\x0a\x42\x10\x2a\x10\x42\x10\x2a\x15\x04\x0f" +
+	"\x2a\x17\x42\x02\x2a\x1b\x42\x01\x2a\x20\x42\x08\x2a\x24\x24\x01\x2a\x26\x22\x08\x2a\x27\x42\x03\x2a\x32\x24\x02\x2a\x36\x24\x01" +
+	"\x2a\x38\x22\x04\x2a\x42\x04\x03\x2a\x44\x42\x02\x2a\x48\x42\x01\x2a\x4b\x42\x03\x2a\x54\x42\x01\x2a\x5a\x42\x01\x2a\x67\x04\x0e" +
+	"\x2a\x69\x04\x0d\x2a\x6a\x42\x0c\x2a\x6f\x26\x08\x2a\x70\x42\x08\x2a\x72\x42\x08\x2a\x74\x22\x08\x2a\x76\x22\x08\x2a\x81\x26\x04" +
+	"\x2a\x82\x42\x04\x2a\x84\x42\x04\x2a\x86\x22\x04\x2a\x88\x22\x04\x2a\x8a\x24\x03\x2a\x8c\x22\x02\x2a\x90\x22\x01\x2a\xb7\x4a\x34" +
+	"\x2a\xb8\x2c\x10\x2a\xba\x2c\x05\x2a\xbc\x2a\x7c\x2a\xbe\x42\x10\x2a\xc0\x08\x20\x2a\xc2\x42\x20\x2a\xc5\x28\x20\x2a\xc6\x42\x20" +
+	"\x2a\xc7\x42\x10\x2a\xca\x42\x10\x2a\xcc\x2a\x79\x2a\xcd\x28\x10\x2a\xd2\x2c\x10\x2a\xd4\x46\x20\x2a\xd7\x28\x34\x2a\xd8\x46\x10" +
+	"\x2a\xd9\x42\x10\x2a\xdd\x28\x20\x2a\xe1\x42\x10\x2a\xe3\x42\x20\x2a\xe4\x46\x11\x2a\xe5\x04\x72\x2a\xe7\x28\x11\x2a\xe9\x26\x70" +
+	"\x2a\xeb\x42\x50\x2a\xee\x42\x10\x2a\xf0\x46\x05\x2a\xf1\x04\x74\x2a\xf6\x42\x20\x2a\xf7\x04\x72\x2a\xf9\x04\x71\x2a\xfb\x42\x20" +
+	"\x2a\xfd\x42\x30\x2b\x03\x42\x10\x2b\x08\x08\x01\x2b\x0a\x46\x24\x2b\x0d\x28\x6c\x2b\x0e\x42\x01\x2b\x13\x04\x6a\x2b\x17\x04\x69" +
+	"\x2b\x19\x42\x60\x2b\x1a\x42\x01\x2b\x1d\x28\x01\x2b\x1f\x26\x68\x2b\x25\x28\x20\x2b\x29\x04\x65\x2b\x2b\x42\x20\x2b\x34\x04\x60" +
+	"\x2b\x35\x04\x63\x2b\x37\x42\x20\x2b\x3a\x24\x20\x2b\x3b\x42\x21\x2b\x3e\x42\x01\x2b\x41\x28\x01\x2b\x43\x26\x40\x2b\x47\x04\x63" +
+	"\x2b\x49\x42\x60\x2b\x4c\x42\x40\x2b\x4d\x42\x61\x2b\x53\x42\x01\x2b\x5a\x2a\x02\x2b\x5c\x46\x04\x2b\x5d\x28\x04\x2b\x62\x42\x20" +
+	"\x2b\x63\x42\x02\x2b\x65\x04\x69\x2b\x67\x42\x20\x2b\x6f\x26\x40\x2b\x74\x2a\x02\x2b\x75\x42\x02\x2b\x77\x28\x05\x2b\x79\x26\x64" +
+	"\x2b\x7d\x42\x02\x2b\x7f\x42\x20\x2b\x82\x24\x20\x2b\x87\x42\x40\x2b\x89\x26\x40\x2b\x8a\x42\x40\x2b\x93\x42\x04\x2b\x99\x42\x22" +
+	"\x2b\x9b\x42\x21\x2b\xaa\x08\x04\x2b\xac\x42\x04\x2b\xaf\x28\x04\x2b\xb0\x42\x04\x2b\xb1\x42\x10\x2b\xbc\x46\x10\x2b\xbd\x46\x10" +
+	"\x2b\xbf\x28\x19\x2b\xc1\x26\x58\x2b\xc3\x42\x10\x2b\xc7\x04\x56\x2b\xcb\x42\x10\x2b\xcd\x42\x14\x2b\xd7\x46\x10\x2b\xd9\x42\x10" +
+	"\x2b\xdc\x24\x10\x2b\xdd\x42\x10\x2b\xde\x42\x10\x2b\xe0\x42\x04\x2b\xe1\x04\x56\x2b\xe3\x28\x04\x2b\xe5\x42\x04\x2b\xe7\x42\x14" +
+	"\x2b\xf3\x42\x10\x2b\xf5\x26\x51\x2b\xf6\x24\x10\x2b\xfd\x04\x4e\x2c\x01\x04\x4d\x2c\x03\x42\x0c\x2c\x0d\x04\x4b\x2c\x0f\x42\x08" +
+	"\x2c\x12\x24\x08\x2c\x13\x42\x09\x2c\x31\x04\x47\x2c\x33\x42\x04\x2c\x36\x42\x40\x2c\x37\x42\x05\x2c\x43\x42\x01\x2c\x46\x24\x01" +
+	"\x2c\x48\x22\x40\x2c\x4c\x42\x04\x2c\x4d\x42\x02\x2c\x4f\x28\x04\x2c\x51\x42\x04\x2c\x5f\x04\x4a\x2c\x61\x26\x40\x2c\x62\x42\x40" +
+	"\x2c\x67\x42\x02\x2c\x69\x42\x04\x2c\x6c\x24\x04\x2c\x79\x04\x43\x2c\x7a\x42\x42\x2c\x7c\x42\x40\x2c\x7e\x22\x40\x2c\x83\x42\x04" +
+	"\x2c\x85\x42\x04\x2c\x86\x24\x04\x2c\x98\x22\x40\x2c\x9e\x42\x10\x2c\xa0\x46\x01\x2c\xa1\x04\x5c\x2c\xa6\x46\x11\x2c\xa7\x04\x5a" +
+	"\x2c\xa9\x28\x01\x2c\xab\x26\x58\x2c\xad\x42\x10\x2c\xb3\x42\x10\x2c\xb8\x46\x01\x2c\xb9\x04\x56\x2c\xbb\x28\x01\x2c\xbd\x26\x54" +
+	"\x2c\xbf\x42\x50\x2c\xc1\x28\x11\x2c\xc3\x26\x52\x2c\xc6\x24\x50\x2c\xc7\x46\x10\x2c\xc8\x42\x10\x2c\xcb\x42\x50\x2c\xcd\x46\x01" +
+	"\x2c\xce\x04\x50\x2c\xd7\x42\x14\x2c\xdd\x42\x12\x2c\xdf\x42\x01\x2c\xee\x42\x01\x2c\xf1\x28\x01\x2c\xf3\x26\x4c\x2c\xf7\x04\x4b" +
+	"\x2c\xf9\x42\x40\x2c\xfc\x24\x40\x2c\xfd\x42\x41\x2d\x03\x42\x01\x2d\x09\x28\x01\x2d\x0b\x26\x46\x2d\x0e\x24\x44\x2d\x0f\x42\x01" +
+	"\x2d\x14\x24\x40\x2d\x18\x04\x41\x2d\x1a\x42\x40\x2d\x1b\x42\x01\x2d\x1e\x24\x01\x2d\x20\x22\x40\x2d\x27\x42\x01\x2d\x2d\x42\x41" +
+	"\x2d\x30\x04\x41\x2d\x32\x42\x40\x2d\x43\x42\x04\x2d\x49\x42\x02\x2d\x4b\x42\x01\x2d\x5b\x42\x40\x2d\x5d\x46\x01\x2d\x5e\x04\x44" +
+	"\x2d\x63\x26\x02\x2d\x64\x42\x02\x2d\x66\x24\x01\x2d\x68\x22\x40\x2d\x70\x42\x40\x2d\x90\x2c\x10\x2d\x92\x46\x04\x2d\x95\x28\x34" +
+	"\x2d\x96\x46\x10\x2d\x97\x42\x10\x2d\x9b\x04\x3a\x2d\x9f\x42\x10\x2d\xa1\x42\x30\x2d\xa2\x46\x01\x2d\xa3\x04\x3a\x2d\xa5\x28\x19" +
+	"\x2d\xa7\x26\x38\x2d\xa9\x42\x18\x2d\xad\x46\x02\x2d\xb1\x42\x10\x2d\xb3\x04\x34\x2d\xbc\x42\x10\x2d\xbd\x28\x01\x2d\xbf\x26\x02" +
+	"\x2d\xc2\x24\x30\x2d\xc3\x42\x01\x2d\xc6\x46\x14\x2d\xc7\x46\x10\x2d\xc9\x28\x14\x2d\xcb\x46\x04\x2d\xcd\x42\x10\x2d\xcf\x04\x33" +
+	"\x2d\xd1\x42\x30\x2d\xd4\x04\x30\x2d\xd5\x42\x30\x2d\xd6\x42\x10\x2d\xd9\x42\x10\x2d\xdb\x26\x31\x2d\xdc\x24\x10\x2d\xe3\x28\x04" +
+	"\x2d\xe7\x04\x2d\x2d\xe9\x42\x04\x2d\xf3\x28\x01\x2d\xf5\x26\x02\x2d\xf8\x24\x28\x2d\xf9\x42\x01\x2e\x04\x42\x04\x2e\x10\x42\x02" +
+	"\x2e\x14\x42\x01\x2e\x17\x04\x27\x2e\x19\x42\x04\x2e\x1c\x24\x04\x2e\x1d\x42\x05\x2e\x29\x42\x01\x2e\x2c\x24\x01\x2e\x2e\x22\x20" +
+	"\x2e\x32\x2a\x02\x2e\x33\x42\x02\x2e\x35\x28\x2d\x2e\x37\x26\x2c\x2e\x3b\x42\x02\x2e\x3d\x42\x20\x2e\x40\x24\x20\x2e\x45\x42\x08" +
+	"\x2e\x47\x26\x29\x2e\x48\x24\x08\x2e\x4d\x42\x02\x2e\x4f\x26\x02\x2e\x52\x24\x24\x2e\x58\x42\x02\x2e\x5f\x42\x01\x2e\x62\x24\x01" +
+	"\x2e\x64\x22\x20\x2e\x69\x04\x26\x2e\x6b\x26\x20\x2e\x6c\x42\x20\x2e\x71\x42\x20\x2e\x72\x42\x22\x2e\x74\x42\x20\x2e\x76\x42\x20" +
+	"\x2e\x7e\x22\x20\x2e\x85\x28\x04\x2e\x89\x42\x10\x2e\x8b\x42\x04\x2e\x95\x46\x02\x2e\x97\x26\x02\x2e\x9a\x24\x18\x2e\x9b\x04\x19" +
+	"\x2e\x9c\x42\x10\x2e\xa6\x42\x14\x2e\xb2\x42\x02\x2e\xb6\x42\x11\x2e\xb9\x28\x04\x2e\xbb\x42\x04\x2e\xbe\x24\x04\x2e\xbf\x42\x04" +
+	"\x2e\xc0\x42\x10\x2e\xcb\x26\x13\x2e\xcc\x24\x10\x2e\xce\x24\x11\x2e\xd0\x22\x10\x2e\xd2\x42\x10\x2e\xdc\x42\x04\x2e\xe8\x42\x02" +
+	"\x2e\xec\x42\x01\x2f\x0c\x24\x04\x2f\x10\x04\x05\x2f\x12\x42\x04\x2f\x1c\x24\x01\x2f\x1e\x22\x02\x2f\x22\x42\x01\x2f\x25\x42\x02" +
+	"\x2f\x27\x42\x04\x2f\x2a\x24\x04\x2f\x37\x26\x02\x2f\x38\x42\x02\x2f\x3a\x24\x09\x2f\x3c\x22\x08\x2f\x42\x42\x02\x2f\x52\x42\x02" +
+	"\x2f\x54\x22\x02\x2f\x5b\x42\x04\x2f\x5c\x42\x02\x2f\x5e\x24\x04\x2f\x60\x42\x04\x2f\x6e\x22\x02\x2f\x70\x22\x01\x2f\x76\x46\x11" +
+	"\x2f\x77\x04\x1e\x2f\x79\x28\x11\x2f\x7b\x26\x1c\x2f\x7d\x42\x18\x2f\x7f\x46\x10\x2f\x81\x42\x10\x2f\x84\x24\x10\x2f\x85\x42\x10" +
+	"\x2f\x86\x42\x10\x2f\x89\x42\x18\x2f\x8b\x46\x01\x2f\x8c\x04\x18\x2f\x91\x28\x01\x2f\x93\x26\x16\x2f\x96\x24\x14\x2f\x97\x42\x01" +
+	"\x2f\x9c\x24\x10\x2f\xa0\x42\x10\x2f\xa2\x42\x10\x2f\xa3\x42\x01\x2f\xa6\x24\x01\x2f\xa8\x22\x10\x2f\xad\x42\x10\x2f\xaf\x46\x10" +
+	"\x2f\xb0\x42\x10\x2f\xb5\x42\x10\x2f\xb6\x42\x10\x2f\xb8\x42\x10\x2f\xba\x42\x10\x2f\xbc\x42\x10\x2f\xc2\x42\x10\x2f\xc7\x04\x0f" +
+	"\x2f\xc9\x42\x04\x2f\xcc\x24\x04\x2f\xcd\x42\x05\x2f\xd9\x42\x01\x2f\xdc\x24\x01\x2f\xde\x22\x08\x2f\xe4\x24\x04\x2f\xe8\x04\x05" +
+	"\x2f\xea\x42\x04\x2f\xf4\x24\x01\x2f\xf6\x22\x02\x2f\xfa\x42\x01\x2f\xfd\x42\x05\x30\x00\x04\x05\x30\x02\x42\x04\x30\x12\x42\x01" +
+	"\x30\x19\x42\x08\x30\x1b\x26\x08\x30\x1c\x42\x08\x30\x21\x04\x0b\x30\x22\x42\x0a\x30\x24\x42\x08\x30\x26\x22\x08\x30\x2e\x42\x08" +
+	"\x30\x33\x42\x01\x30\x36\x24\x01\x30\x38\x22\x04\x30\x3c\x42\x02\x30\x3e\x22\x02\x30\x48\x42\x01\x30\x6a\x42\x10\x30\x6c\x46\x05" +
+	"\x30\x6d\x04\x3c\x30\x72\x42\x20\x30\x73\x04\x3a\x30\x75\x04\x39\x30\x77\x42\x20\x30\x79\x42\x30\x30\x7f\x42\x10\x30\x84\x46\x30" +
+	"\x30\x85\x46\x10\x30\x87\x08\x35\x30\x89\x46\x20\x30\x8b\x42\x10\x30\x8d\x08\x20\x30\x8f\x42\x20\x30\x92\x24\x20\x30\x93\x42\x20" +
+	"\x30\x94\x42\x10\x30\x97\x42\x10\x30\x99\x46\x10\x30\x9a\x42\x10\x30\xa3\x42\x14\x30\xa9\x42\x32\x30\xab\x42\x21\x30\xba\x42\x01" +
+	"\x30\xbd\x28\x01\x30\xbf\x26\x08\x30\xc3\x04\x2b\x30\xc5\x42\x20\x30\xc8\x42\x08\x30\xc9\x42\x21\x30\xcf\x42\x01\x30\xd5\x04\x27" +
+	"\x30\xd7\x42\x20\x30\xda\x24\x20\x30\xdb\x42\x21\x30\xe0\x24\x20\x30\xe4\x04\x21\x30\xe6\x42\x20\x30\xe7\x42\x21\x30\xea\x04\x21" +
+	"\x30\xec\x42\x20\x30\xf3\x42\x01\x30\xf9\x42\x21\x30\xfc\x42\x01\x31\x0f\x42\x04\x31\x15\x42\x22\x31\x17\x42\x21\x31\x27\x04\x26" +
+	"\x31\x29\x46\x04\x31\x2a\x42\x04\x31\x2f\x42\x20\x31\x30\x42\x02\x31\x32\x04\x21\x31\x34\x42\x20\x31\x5c\x42\x04\x31\x5d\x04\x1e" +
+	"\x31\x5f\x08\x04\x31\x61\x42\x04\x31\x63\x42\x14\x31\x6f\x42\x10\x31\x71\x46\x10\x31\x72\x42\x10\x31\x77\x04\x17\x31\x79\x42\x14" +
+	"\x31\x7c\x04\x14\x31\x7d\x42\x14\x31\x7e\x42\x10\x31\x89\x42\x10\x31\x8a\x42\x10\x31\x8c\x42\x10\x31\x8e\x42\x10\x31\x90\x42\x10" +
+	"\x31\x93\x42\x14\x31\x95\x42\x04\x31\x96\x04\x14\x31\xa8\x42\x10\x31\xad\x04\x0f\x31\xaf\x42\x0c\x31\xb2\x42\x08\x31\xb3\x42\x0d" +
+	"\x31\xbf\x42\x09\x31\xc2\x04\x09\x31\xc4\x42\x08\x31\xe3\x42\x05\x31\xe6\x42\x01\x31\xf8\x42\x01\x31\xff\x42\x04\x32\x01\x42\x04" +
+	"\x32\x02\x42\x04\x32\x19\x42\x04\x32\x1a\x42\x06\x32\x1c\x42\x04\x32\x1e\x42\x04\x32\x38\x42\x04\x32\x53\x42\x14\x32\x59\x42\x12" +
+	"\x32\x5b\x42\x01\x32\x6b\x42\x10\x32\x6d\x46\x01\x32\x6e\x04\x14\x32\x73\x46\x01\x32\x74\x04\x12\x32\x76\x24\x01\x32\x78\x22\x10" +
+	"\x32\x7a\x42\x10\x32\x80\x42\x10\x32\xa3\x42\x01\x32\xa9\x42\x01\x32\xac\x24\x01\x32\xae\x22\x08\x32\xbb\x42\x01\x32\xbe\x24\x01" +
+	"\x32\xc0\x22\x04\x32\xc4\x24\x01\x32\xc6\x22\x02\x32\xca\x42\x01\x32\xd0\x42\x01\x32\xe2\x42\x01\x33\x10\x42\x04\x33\x16\x42\x02" +
+	"\x33\x18\x42\x01\x33\x43\x4a\x44\x33\x45\x4a\x04\x33\x46\x4a\x04\x33\x4b\x4a\x43\x33\x4c\x08\x02\x33\x4e\x08\x01\x33\x50\x46\x10" +
+	"\x33\x52\x46\x40\x33\x58\x08\x20\x33\x5d\x4a\x40\x33\x5e\x4a\x40\x33\x60\x4a\x44\x33\x62\x46\x10\x33\x64\x42\x40\x33\x66\x2c\x40" +
+	"\x33\x68\x46\x10\x33\x6b\x42\x10\x33\x6c\x46\x50\x33\x6d\x28\x40\x33\x70\x42\x40\x33\x72\x08\x20\x33\x73\x42\x20\x33\x7c\x42\x04" +
+	"\x33\x82\x42\x02\x33\x84\x42\x01\x33\x93\x2e\xef\x33\x94\x2c\x44\x33\x96\x2c\x80\x33\x98\x42\x80\x33\x9a\x46\x48\x33\x9c\x2c\x40" +
+	"\x33\x9e\x42\x40\x33\xa1\x04\xe8\x33\xa2\x42\x40\x33\xa3\x28\x40\x33\xa6\x2a\x20\x33\xa8\x42\x80\x33\xa9\x42\x20\x33\xae\x2c\x20" +
+	"\x33\xb0\x42\x20\x33\xb3\x04\xe4\x33\xb4\x42\x20\x33\xb5\x04\xe4\x33\xb9\x04\xe2\x33\xbd\x04\xe1\x33\xbf\x42\x60\x33\xc0\x42\x20" +
+	"\x33\xc1\x42\x20\x33\xc3\x42\x20\x33\xc5\x42\xa0\x33\xc7\x42\x60\x33\xca\x46\x06\x33\xcc\x42\x80\x33\xcd\x04\xe4\x33\xd2\x42\x40" +
+	"\x33\xd3\x04\xe2\x33\xd5\x04\xe1\x33\xd7\x42\xc0\x33\xd9\x42\x40\x33\xdf\x42\x80\x33\xe8\x42\x04\x33\xee\x42\x02\x33\xf0\x42\x01" +
+	"\x34\x00\x42\x40\x34\x02\x46\x01\x34\x03\x04\xe4\x34\x08\x46\x01\x34\x09\x04\xe2\x34\x0b\x42\x01\x34\x0f\x42\x40\x34\x15\x42\x40" +
+	"\x34\x35\x2e\xd1\x34\x36\x2c\x58\x34\x38\x4a\x40\x34\x3a\x46\x10\x34\x3c\x46\x08\x34\x3e\x4a\xc0\x34\x40\x46\x10\x34\x43\x42\x10" +
+	"\x34\x44\x46\x10\x34\x45\x46\x80\x34\x48\x46\x08\x34\x4a\x46\x10\x34\x4b\x46\x40\x34\x50\x4a\x10\x34\x52\x42\x10\x34\x55\x42\x10" +
+	"\x34\x56\x42\x10\x34\x57\x04\xd4\x34\x5b\x42\x10\x34\x5f\x46\x10\x34\x61\x42\x10\x34\x62\x42\x10\x34\x63\x04\xd2\x34\x65\x46\x10" +
+	"\x34\x67\x42\x10\x34\x69\x42\x50\x34\x6c\x2a\xd6\x34\x6e\x2a\xd1\x34\x6f\x28\x04\x34\x74\x2a\x41\x34\x75\x28\x02\x34\x77\x46\x01" +
+	"\x34\x79\x04\xd0\x34\x7b\x26\xd0\x34\x81\x26\xd0\x34\x86\x2c\x08\x34\x88\x42\x08\x34\x8b\x04\xcc\x34\x8c\x42\x08\x34\x8d\x28\x08" +
+	"\x34\x91\x04\xca\x34\x95\x04\xc9\x34\x97\x42\x48\x34\x98\x42\x08\x34\x99\x28\x08\x34\x9b\x04\xc9\x34\x9d\x42\x88\x34\x9f\x42\x08" +
+	"\x34\xbc\x2a\xc3\x34\xbd\x28\xc6\x34\xbf\x28\x80\x34\xc1\x42\x80\x34\xc3\x26\xc4\x34\xc5\x46\x40\x34\xc7\x42\x40\x34\xca\x42\x40" +
+	"\x34\xcb\x42\x40\x34\xcc\x24\x40\x34\xcf\x26\xc2\x34\xd1\x42\x80\x34\xd2\x24\x80\x34\xd8\x2a\xc6\x34\xda\x2a\x01\x34\xdb\x28\x04" +
+	"\x34\xe0\x2a\x01\x34\xe1\x28\x02\x34\xe3\x42\x01\x34\xe7\x26\x80\x34\xed\x26\x40\x34\xf2\x08\x01\x34\xf3\x46\x40\x34\xf5\x42\x01" +
+	"\x34\xf9\x42\x40\x34\xfb\x42\x01\x35\x01\x04\xc1\x35\x02\x42\x80\x35\x05\x42\x40\x35\x07\x04\xc1\x35\x08\x42\x40\x35\x11\x42\x04" +
+	"\x35\x17\x42\x02\x35\x19\x42\x01\x35\x2c\x42\x04\x35\x32\x42\x02\x35\x34\x42\x01\x35\x44\x42\x40\x35\x46\x4a\x04\x35\x47\x42\x04" +
+	"\x35\x4c\x46\x41\x35\x4d\x04\xd2\x35\x4f\x08\x01\x35\x51\x46\x10\x35\x53\x42\x40\x35\x7a\x46\x04\x35\x7c\x42\x80\x35\x7d\x42\x04" +
+	"\x35\x82\x42\x40\x35\x83\x04\xca\x35\x85\x04\xc9\x35\x87\x42\xc0\x35\x89\x42\x40\x35\x94\x2a\x04\x35\x95\x42\x04\x35\x97\x42\x04" +
+	"\x35\x99\x42\x80\x35\x9b\x42\x40\x35\x9d\x28\x40\x35\x9f\x42\x40\x35\xa2\x04\xc0\x35\xa3\x42\x40\x35\xa4\x24\x40\x35\xb3\x42\x84" +
+	"\x35\xb9\x42\x42\x35\xbb\x42\xc1\x35\xe9\x42\x44\x35\xef\x42\x42\x35\xf1\x42\x01\x36\x1b\x4a\x0d\x36\x1c\x08\x08\x36\x1e\x2c\x04" +
+	"\x36\x20\x46\x10\x36\x22\x42\x08\x36\x24\x08\x10\x36\x26\x42\x10\x36\x29\x42\x10\x36\x2a\x42\x10\x36\x2b\x04\xb8\x36\x2e\x42\x08" +
+	"\x36\x30\x2a\x20\x36\x31\x42\x20\x36\x36\x08\x01\x36\x38\x46\x10\x36\x3b\x42\x10\x36\x3c\x42\x01\x36\x41\x42\x10\x36\x45\x04\xb1" +
+	"\x36\x47\x42\x10\x36\x48\x42\x01\x36\x4b\x42\x20\x36\x4d\x04\xb0\x36\x52\x46\x04\x36\x54\x46\x14\x36\x55\x28\x04\x36\x5a\x42\x10" +
+	"\x36\x5b\x04\xb2\x36\x5d\x04\xb1\x36\x5f\x42\x10\x36\x61\x42\x10\x36\x67\x26\x20\x36\x6c\x2c\x04\x36\x6e\x42\x04\x36\x71\x04\xac" +
+	"\x36\x72\x42\x04\x36\x73\x04\xac\x36\x7e\x2a\x20\x36\x7f\x42\x20\x36\x81\x42\x20\x36\x83\x42\x80\x36\x85\x42\x08\x36\x89\x04\xa6" +
+	"\x36\x8d\x04\xa5\x36\x8f\x42\x24\x36\x99\x42\x20\x36\x9b\x42\x20\x36\x9e\x42\x20\x36\x9f\x42\x21\x36\xa2\x42\x04\x36\xa3\x28\x04" +
+	"\x36\xa5\x04\xa5\x36\xa7\x42\x84\x36\xa9\x42\x04\x36\xb5\x26\x20\x36\xb7\x42\x80\x36\xb8\x42\x20\x36\xbe\x42\x08\x36\xc0\x46\x01" +
+	"\x36\xc1\x04\xac\x36\xc6\x46\x01\x36\xc7\x04\xaa\x36\xc9\x42\x01\x36\xcd\x42\x08\x36\xd3\x42\x08\x36\xd8\x42\x01\x36\xdb\x42\x01" +
+	"\x36\xe1\x42\x01\x36\xe7\x42\x01\x36\xed\x42\x01\x36\xf7\x42\x04\x36\xfd\x42\x02\x36\xff\x42\x01\x37\x0e\x2c\x18\x37\x10\x46\x10" +
+	"\x37\x13\x42\x10\x37\x14\x46\x18\x37\x15\x28\x08\x37\x19\x42\x10\x37\x1d\x46\x10\x37\x1f\x42\x10\x37\x20\x46\x08\x37\x21\x28\x08" +
+	"\x37\x23\x28\x18\x37\x25\x46\x10\x37\x27\x42\x08\x37\x2b\x42\x10\x37\x2f\x04\x95\x37\x31\x42\x10\x37\x3a\x42\x10\x37\x3b\x04\x93" +
+	"\x37\x3d\x42\x10\x37\x40\x42\x10\x37\x41\x42\x11\x37\x44\x2a\x97\x37\x45\x28\x16\x37\x47\x28\x14\x37\x49\x46\x10\x37\x4b\x26\x94" +
+	"\x37\x4d\x28\x10\x37\x4f\x42\x10\x37\x52\x42\x10\x37\x53\x42\x10\x37\x54\x24\x10\x37\x57\x26\x92\x37\x59\x26\x91\x37\x5a\x24\x90" +
+	"\x37\x61\x04\x8e\x37\x65\x04\x8d\x37\x67\x42\x0c\x37\x71\x28\x08\x37\x73\x42\x08\x37\x76\x04\x88\x37\x77\x42\x08\x37\x78\x24\x08" +
+	"\x37\x95\x28\x04\x37\x97\x42\x04\x37\x9a\x04\x84\x37\x9b\x42\x04\x37\x9c\x24\x04\x37\xa7\x26\x83\x37\xa8\x24\x82\x37\xaa\x24\x80" +
+	"\x37\xac\x42\x80\x37\xae\x22\x80\x37\xb0\x2a\x01\x37\xb1\x28\x08\x37\xb3\x42\x01\x37\xb7\x42\x08\x37\xb9\x42\x01\x37\xbf\x04\x89" +
+	"\x37\xc0\x42\x80\x37\xc3\x42\x08\x37\xc5\x26\x01\x37\xc6\x24\x08\x37\xcb\x42\x01\x37\xd1\x42\x01\x37\xda\x42\x81\x37\xdd\x42\x01" +
+	"\x37\xe0\x42\x01\x37\xe7\x26\x86\x37\xe9\x26\x01\x37\xea\x24\x04\x37\xef\x26\x01\x37\xf0\x24\x02\x37\xf2\x42\x01\x37\xf6\x22\x80" +
+	"\x37\xfc\x22\x80\x38\x02\x42\x08\x38\x04\x46\x04\x38\x05\x42\x04\x38\x0a\x42\x10\x38\x0b\x04\x9a\x38\x0d\x04\x99\x38\x0f\x42\x10" +
+	"\x38\x11\x42\x18\x38\x1c\x42\x01\x38\x1f\x42\x04\x38\x21\x04\x94\x38\x25\x04\x93\x38\x27\x42\x10\x38\x2a\x42\x10\x38\x2b\x42\x11" +
+	"\x38\x3b\x42\x04\x38\x41\x42\x12\x38\x43\x42\x11\x38\x52\x42\x04\x38\x53\x42\x04\x38\x55\x42\x04\x38\x57\x42\x84\x38\x59\x42\x0c" +
+	"\x38\x6d\x42\x04\x38\x6f\x42\x04\x38\x72\x42\x04\x38\x73\x42\x05\x38\x89\x42\x04\x38\x8b\x42\x84\x38\x8c\x42\x04\x38\xa7\x42\x0c" +
+	"\x38\xad\x42\x0a\x38\xaf\x42\x01\x38\xc1\x42\x01\x38\xc7\x42\x01\x38\xca\x42\x01\x38\xf8\x42\x04\x38\xfe\x42\x02\x39\x00\x42\x01" +
+	"\x39\x10\x08\x80\x39\x12\x08\x80\x39\x13\x42\x80\x39\x18\x2a\x80\x39\x19\x42\x80\x39\x1b\x42\x80\x39\x1d\x04\xb0\x39\x1f\x26\x80" +
+	"\x39\x25\x04\xb0\x39\x46\x2a\x80\x39\x48\x42\x80\x39\x49\x42\x80\x39\x4e\x2a\x80\x39\x4f\x42\x80\x39\x51\x42\x80\x39\x53\x42\x80" +
+	"\x39\x55\x26\x80\x39\x5b\x42\x80\x39\x60\x42\x20\x39\x61\x42\x80\x39\x63\x42\x80\x39\x65\x42\xa0\x39\x67\x42\x20\x39\x69\x42\x80" +
+	"\x39\x6b\x42\x20\x39\x6e\x42\x80\x39\x6f\x42\x20\x39\x70\x42\x80\x39\x73\x42\x20\x39\x75\x42\xa0\x39\x76\x42\xa0\x39\x7f\x42\x84" +
+	"\x39\x85\x42\x02\x39\x87\x42\x81\x39\xb5\x42\x04\x39\xbb\x42\x02\x39\xbd\x42\x01\x39\xe8\x2a\x80\x39\xea\x08\x80\x39\xeb\x42\x80" +
+	"\x39\xf0\x08\x80\x39\xf1\x42\x80\x39\xf3\x42\x80\x39\xf5\x04\x98\x39\xf7\x26\x80\x39\xfd\x04\x98\x3a\x02\x42\x10\x3a\x03\x42\x80" +
+	"\x3a\x05\x42\x80\x3a\x07\x42\x10\x3a\x09\x42\x10\x3a\x0b\x42\x80\x3a\x0d\x42\x10\x3a\x10\x42\x90\x3a\x11\x42\x10\x3a\x12\x42\x80" +
+	"\x3a\x15\x42\x10\x3a\x17\x42\x10\x3a\x18\x42\x90\x3a\x21\x42\x04\x3a\x27\x42\x02\x3a\x29\x42\x01\x3a\x38\x42\x08\x3a\x39\x42\x80" +
+	"\x3a\x3b\x42\x80\x3a\x3d\x42\x88\x3a\x3f\x42\x08\x3a\x41\x42\x80\x3a\x43\x42\x08\x3a\x46\x42\x88\x3a\x47\x42\x08\x3a\x48\x42\x80" +
+	"\x3a\x4b\x42\x08\x3a\x4d\x42\x88\x3a\x4e\x42\x80\x3a\x6f\x26\x80\x3a\x71\x42\x80\x3a\x72\x42\x80\x3a\x77\x04\x83\x3a\x78\x42\x80" +
+	"\x3a\x7a\x42\x81\x3a\x7e\x22\x80\x3a\x84\x42\x80\x3a\x8d\x42\x04\x3a\x93\x42\x02\x3a\x95\x42\x01\x3a\xa5\x04\x86\x3a\xa7\x04\x85" +
+	"\x3a\xa8\x42\x84\x3a\xad\x04\x83\x3a\xae\x42\x80\x3a\xb0\x42\x81\x3a\xb4\x22\x80\x3a\xf9\x42\x04\x3a\xff\x42\x02\x3b\x01\x42\x01" +
+	"\x3b\x2f\x42\x84\x3b\x35\x42\x02\x3b\x37\x42\x81\x3b\x47\x04\x86\x3b\x49\x42\x80\x3b\x4a\x42\x84\x3b\x4f\x26\x80\x3b\x50\x42\x80" +
+	"\x3b\x52\x42\x80\x3b\x54\x42\x80\x3b\x56\x22\x80\x3b\xcd\x2e\x35\x3b\xce\x2c\x16\x3b\xd0\x4a\x10\x3b\xd2\x42\x10\x3b\xd4\x2a\x7c" +
+	"\x3b\xd6\x2c\x12\x3b\xd8\x46\x10\x3b\xdb\x42\x10\x3b\xdc\x2a\x79\x3b\xdd\x28\x58\x3b\xe0\x2a\x20\x3b\xe2\x42\x10\x3b\xe3\x42\x20" +
+	"\x3b\xe8\x4a\x04\x3b\xea\x46\x10\x3b\xed\x42\x10\x3b\xee\x2a\x35\x3b\xef\x28\x40\x3b\xf3\x42\x10\x3b\xf7\x28\x50\x3b\xf9\x46\x10" +
+	"\x3b\xfa\x08\x20\x3b\xfb\x42\x20\x3b\xfd\x42\x20\x3b\xff\x42\x10\x3c\x01\x42\x40\x3c\x04\x46\x02\x3c\x06\x42\x10\x3c\x07\x04\x74" +
+	"\x3c\x0c\x46\x12\x3c\x0d\x28\x02\x3c\x0f\x04\x71\x3c\x11\x42\x10\x3c\x13\x26\x70\x3c\x19\x42\x10\x3c\x1e\x2c\x02\x3c\x20\x42\x02" +
+	"\x3c\x24\x2a\x2d\x3c\x25\x28\x6c\x3c\x29\x04\x6a\x3c\x2d\x28\x40\x3c\x2f\x42\x40\x3c\x30\x42\x02\x3c\x31\x42\x20\x3c\x37\x26\x20" +
+	"\x3c\x3b\x04\x66\x3c\x3f\x28\x20\x3c\x41\x42\x20\x3c\x4a\x04\x60\x3c\x4b\x42\x20\x3c\x4d\x42\x22\x3c\x51\x42\x20\x3c\x52\x42\x20" +
+	"\x3c\x54\x42\x02\x3c\x55\x28\x02\x3c\x5b\x26\x64\x3c\x5d\x04\x63\x3c\x5f\x42\x42\x3c\x63\x42\x40\x3c\x64\x24\x40\x3c\x67\x42\x02" +
+	"\x3c\x70\x2a\x6c\x3c\x72\x08\x01\x3c\x73\x46\x04\x3c\x78\x2a\x01\x3c\x79\x28\x02\x3c\x7b\x42\x01\x3c\x7f\x26\x68\x3c\x85\x04\x68" +
+	"\x3c\x8a\x2a\x01\x3c\x8b\x28\x40\x3c\x8d\x42\x01\x3c\x91\x42\x40\x3c\x93\x42\x01\x3c\x99\x26\x01\x3c\x9a\x24\x40\x3c\x9d\x42\x40" +
+	"\x3c\x9f\x04\x61\x3c\xa0\x42\x60\x3c\xa9\x42\x04\x3c\xaf\x42\x02\x3c\xb1\x42\x01\x3c\xc0\x2c\x1a\x3c\xc2\x46\x10\x3c\xc5\x42\x10" +
+	"\x3c\xc6\x2a\x1d\x3c\xc7\x28\x58\x3c\xcb\x42\x10\x3c\xcf\x28\x58\x3c\xd1\x46\x10\x3c\xd2\x46\x10\x3c\xd3\x28\x18\x3c\xd5\x46\x10" +
+	"\x3c\xd7\x42\x10\x3c\xd9\x46\x08\x3c\xdd\x42\x10\x3c\xe1\x28\x10\x3c\xe3\x42\x10\x3c\xec\x42\x10\x3c\xed\x46\x10\x3c\xef\x42\x10" +
+	"\x3c\xf2\x42\x10\x3c\xf3\x42\x10\x3c\xf4\x04\x50\x3c\xf6\x2a\x53\x3c\xf7\x28\x16\x3c\xf9\x28\x10\x3c\xfb\x42\x10\x3c\xfd\x26\x54" +
+	"\x3c\xff\x46\x01\x3d\x01\x04\x52\x3d\x04\x42\x50\x3d\x05\x26\x51\x3d\x06\x24\x50\x3d\x09\x26\x52\x3d\x0b\x42\x10\x3d\x0c\x24\x10" +
+	"\x3d\x13\x04\x4e\x3d\x17\x28\x08\x3d\x19\x42\x08\x3d\x22\x04\x48\x3d\x23\x04\x4b\x3d\x25\x42\x0a\x3d\x29\x42\x08\x3d\x2a\x24\x08" +
+	"\x3d\x47\x28\x02\x3d\x49\x42\x02\x3d\x4d\x26\x45\x3d\x4e\x24\x44\x3d\x52\x42\x40\x3d\x56\x24\x40\x3d\x58\x42\x40\x3d\x59\x42\x02" +
+	"\x3d\x5a\x24\x02\x3d\x60\x22\x40\x3d\x62\x2a\x01\x3d\x63\x28\x4e\x3d\x65\x42\x01\x3d\x69\x26\x4c\x3d\x6b\x42\x01\x3d\x71\x26\x01" +
+	"\x3d\x72\x24\x48\x3d\x75\x26\x40\x3d\x77\x04\x49\x3d\x78\x42\x40\x3d\x7d\x42\x01\x3d\x83\x26\x01\x3d\x84\x24\x40\x3d\x8c\x42\x01" +
+	"\x3d\x8f\x04\x43\x3d\x90\x42\x40\x3d\x92\x42\x41\x3d\x96\x42\x40\x3d\x99\x26\x46\x3d\x9b\x26\x01\x3d\x9c\x24\x04\x3d\xa1\x26\x01" +
+	"\x3d\xa2\x24\x02\x3d\xa4\x42\x01\x3d\xa8\x22\x40\x3d\xae\x22\x40\x3d\xb4\x4a\x04\x3d\xb6\x42\x10\x3d\xb7\x42\x04\x3d\xbc\x46\x12" +
+	"\x3d\xbd\x08\x02\x3d\xbf\x04\x59\x3d\xc1\x42\x10\x3d\xc3\x46\x40\x3d\xce\x4a\x04\x3d\xcf\x42\x04\x3d\xd1\x42\x04\x3d\xd3\x42\x10" +
+	"\x3d\xd5\x42\x40\x3d\xd7\x08\x53\x3d\xd9\x46\x10\x3d\xdc\x42\x10\x3d\xdd\x46\x40\x3d\xde\x24\x40\x3d\xed\x42\x14\x3d\xf3\x42\x02" +
+	"\x3d\xf5\x42\x11\x3e\x04\x42\x02\x3e\x05\x42\x04\x3e\x0b\x26\x04\x3e\x0d\x04\x4b\x3e\x0f\x42\x42\x3e\x13\x42\x40\x3e\x14\x24\x40" +
+	"\x3e\x1f\x42\x04\x3e\x21\x42\x02\x3e\x25\x26\x04\x3e\x26\x42\x04\x3e\x2a\x04\x42\x3e\x2e\x24\x40\x3e\x30\x42\x40\x3e\x3b\x42\x02" +
+	"\x3e\x43\x42\x42\x3e\x44\x04\x42\x3e\x4a\x42\x40\x3e\x59\x42\x04\x3e\x5f\x42\x02\x3e\x61\x42\x01\x3e\x71\x42\x40\x3e\x73\x04\x45" +
+	"\x3e\x74\x42\x04\x3e\x79\x46\x01\x3e\x7a\x04\x42\x3e\x7c\x42\x01\x3e\x80\x42\x40\x3e\xa6\x4a\x24\x3e\xa8\x46\x10\x3e\xab\x42\x10" +
+	"\x3e\xac\x2a\x05\x3e\xad\x28\x08\x3e\xb1\x42\x10\x3e\xb5\x28\x10\x3e\xb7\x42\x10\x3e\xb8\x08\x20\x3e\xb9\x42\x20\x3e\xbb\x42\x20" +
+	"\x3e\xbd\x42\x10\x3e\xbf\x42\x08\x3e\xc3\x42\x10\x3e\xc7\x46\x01\x3e\xc9\x04\x34\x3e\xd2\x42\x10\x3e\xd3\x42\x20\x3e\xd5\x04\x32" +
+	"\x3e\xd8\x42\x30\x3e\xd9\x42\x01\x3e\xdc\x46\x10\x3e\xdd\x46\x20\x3e\xdf\x46\x10\x3e\xe1\x42\x10\x3e\xe3\x26\x34\x3e\xe5\x46\x10" +
+	"\x3e\xe7\x42\x10\x3e\xea\x42\x10\x3e\xeb\x42\x10\x3e\xec\x24\x10\x3e\xef\x26\x20\x3e\xf1\x42\x10\x3e\xf2\x42\x20\x3e\xf9\x04\x2e" +
+	"\x3e\xfd\x46\x04\x3e\xff\x42\x04\x3f\x09\x42\x20\x3f\x0b\x42\x02\x3f\x0f\x04\x29\x3f\x10\x42\x20\x3f\x1a\x42\x04\x3f\x26\x42\x22" +
+	"\x3f\x2a\x42\x21\x3f\x2d\x04\x27\x3f\x2f\x42\x06\x3f\x33\x42\x04\x3f\x34\x24\x04\x3f\x3f\x42\x02\x3f\x40\x42\x20\x3f\x46\x22\x20" +
+	"\x3f\x48\x2a\x01\x3f\x49\x28\x08\x3f\x4b\x42\x01\x3f\x4f\x42\x08\x3f\x51\x42\x01\x3f\x57\x26\x01\x3f\x58\x24\x08\x3f\x5b\x42\x08" +
+	"\x3f\x5d\x04\x29\x3f\x5e\x42\x20\x3f\x63\x42\x01\x3f\x69\x42\x01\x3f\x72\x42\x01\x3f\x75\x42\x01\x3f\x78\x42\x21\x3f\x7f\x26\x20" +
+	"\x3f\x81\x04\x25\x3f\x82\x42\x20\x3f\x87\x04\x23\x3f\x88\x42\x20\x3f\x8a\x42\x21\x3f\x8e\x22\x20\x3f\x94\x22\x20\x3f\x9b\x42\x10" +
+	"\x3f\x9f\x46\x01\x3f\xa1\x04\x1c\x3f\xaa\x42\x10\x3f\xab\x46\x01\x3f\xad\x04\x1a\x3f\xb0\x42\x10\x3f\xb1\x26\x01\x3f\xb2\x24\x08" +
+	"\x3f\xbc\x42\x14\x3f\xc8\x42\x12\x3f\xcc\x42\x01\x3f\xcf\x28\x16\x3f\xd1\x46\x10\x3f\xd4\x42\x10\x3f\xd5\x26\x15\x3f\xd6\x24\x14" +
+	"\x3f\xda\x42\x10\x3f\xde\x24\x10\x3f\xe0\x42\x10\x3f\xe1\x26\x13\x3f\xe2\x24\x12\x3f\xe4\x24\x10\x3f\xe6\x42\x10\x3f\xe8\x22\x10" +
+	"\x3f\xf2\x42\x04\x3f\xfe\x42\x02\x40\x02\x42\x01\x40\x22\x04\x06\x40\x26\x24\x04\x40\x28\x42\x04\x40\x32\x24\x02\x40\x34\x42\x02" +
+	"\x40\x38\x22\x01\x40\x3b\x42\x01\x40\x41\x26\x01\x40\x42\x24\x08\x40\x4a\x42\x01\x40\x4d\x26\x01\x40\x4e\x24\x08\x40\x50\x42\x01" +
+	"\x40\x54\x42\x08\x40\x5c\x42\x01\x40\x68\x42\x01\x40\x6e\x42\x01\x40\x71\x26\x01\x40\x72\x24\x06\x40\x74\x42\x01\x40\x78\x22\x04" +
+	"\x40\x7a\x42\x01\x40\x80\x22\x01\x40\x84\x22\x02\x40\x86\x22\x01\x40\x8c\x08\x04\x40\x8d\x42\x04\x40\x8f\x42\x04\x40\x91\x42\x10" +
+	"\x40\x93\x42\x08\x40\x95\x46\x10\x40\x97\x42\x10\x40\x9a\x42\x10\x40\x9b\x42\x10\x40\x9c\x04\x18\x40\xa7\x42\x04\x40\xa9\x04\x16" +
+	"\x40\xac\x42\x14\x40\xad\x42\x01\x40\xb2\x42\x10\x40\xb6\x04\x11\x40\xb8\x42\x10\x40\xc3\x04\x16\x40\xc5\x42\x10\x40\xc6\x42\x14" +
+	"\x40\xcb\x42\x10\x40\xcc\x42\x10\x40\xce\x42\x10\x40\xd0\x42\x10\x40\xd2\x42\x10\x40\xdd\x42\x04\x40\xdf\x42\x06\x40\xe3\x42\x04" +
+	"\x40\xe4\x42\x04\x40\xfa\x42\x04\x40\xfe\x42\x04\x41\x00\x42\x04\x41\x13\x42\x06\x41\x14\x42\x04\x41\x1a\x42\x04\x41\x2f\x42\x08" +
+	"\x41\x31\x04\x0d\x41\x32\x42\x0c\x41\x37\x04\x0b\x41\x38\x42\x08\x41\x3a\x42\x09\x41\x3e\x42\x08\x41\x49\x42\x01\x41\x4c\x42\x05" +
+	"\x41\x52\x42\x01\x41\x58\x42\x01\x41\x80\x46\x02\x41\x82\x42\x10\x41\x83\x04\x3c\x41\x88\x46\x02\x41\x89\x28\x02\x41\x8b\x04\x39" +
+	"\x41\x8d\x42\x10\x41\x8f\x26\x38\x41\x95\x42\x10\x41\x9a\x46\x10\x41\x9b\x46\x04\x41\x9d\x46\x10\x41\x9f\x42\x10\x41\xa1\x26\x34" +
+	"\x41\xa3\x28\x12\x41\xa5\x46\x10\x41\xa8\x42\x10\x41\xa9\x26\x31\x41\xaa\x24\x30\x41\xad\x04\x32\x41\xaf\x42\x10\x41\xb0\x42\x30" +
+	"\x41\xb9\x42\x14\x41\xbf\x42\x02\x41\xc1\x42\x11\x41\xd0\x42\x02\x41\xd1\x28\x02\x41\xd7\x26\x2c\x41\xd9\x28\x02\x41\xdb\x42\x02" +
+	"\x41\xdf\x26\x29\x41\xe0\x24\x28\x41\xe3\x42\x02\x41\xeb\x04\x27\x41\xed\x42\x22\x41\xf1\x42\x20\x41\xf2\x24\x20\x41\xf6\x04\x22" +
+	"\x41\xfa\x24\x20\x41\xfc\x42\x20\x41\xfd\x42\x22\x41\xfe\x42\x20\x42\x04\x42\x20\x42\x07\x42\x02\x42\x0f\x42\x02\x42\x10\x24\x02" +
+	"\x42\x16\x22\x20\x42\x25\x42\x04\x42\x2b\x42\x02\x42\x2d\x42\x01\x42\x3d\x26\x04\x42\x3f\x04\x25\x42\x40\x42\x04\x42\x45\x26\x01" +
+	"\x42\x46\x24\x02\x42\x48\x42\x01\x42\x4c\x22\x20\x42\x72\x46\x10\x42\x73\x28\x12\x42\x75\x46\x10\x42\x77\x42\x10\x42\x79\x26\x1c" +
+	"\x42\x7b\x46\x01\x42\x7d\x04\x1a\x42\x80\x42\x18\x42\x81\x26\x19\x42\x82\x24\x18\x42\x85\x46\x10\x42\x87\x42\x10\x42\x88\x42\x10" +
+	"\x42\x8d\x46\x10\x42\x8f\x42\x10\x42\x92\x42\x10\x42\x93\x42\x10\x42\x94\x24\x10\x42\x98\x42\x10\x42\x9c\x24\x10\x42\x9e\x42\x10" +
+	"\x42\x9f\x42\x10\x42\xa0\x42\x10\x42\xa2\x42\x10\x42\xa4\x42\x10\x42\xa6\x42\x10\x42\xa9\x46\x02\x42\xab\x42\x10\x42\xac\x04\x14" +
+	"\x42\xb1\x26\x01\x42\xb2\x24\x02\x42\xb4\x42\x01\x42\xb8\x22\x10\x42\xbe\x42\x10\x42\xc3\x04\x0f\x42\xc5\x42\x0a\x42\xc9\x42\x08" +
+	"\x42\xca\x24\x08\x42\xce\x42\x08\x42\xd2\x24\x08\x42\xd4\x42\x08\x42\xd5\x42\x0a\x42\xd6\x04\x0a\x42\xdc\x42\x08\x42\xf9\x42\x02" +
+	"\x42\xfa\x24\x02\x43\x00\x22\x04\x43\x02\x42\x01\x43\x08\x22\x01\x43\x0c\x42\x02\x43\x15\x26\x04\x43\x17\x04\x0d\x43\x18\x42\x04" +
+	"\x43\x1d\x26\x01\x43\x1e\x24\x02\x43\x20\x42\x01\x43\x24\x22\x08\x43\x2f\x04\x07\x43\x30\x42\x04\x43\x32\x42\x05\x43\x36\x22\x04" +
+	"\x43\x38\x42\x01\x43\x3e\x22\x01\x43\x4e\x42\x04\x43\x54\x42\x02\x43\x56\x42\x01\x43\x69\x42\x14\x43\x6f\x42\x02\x43\x71\x42\x11" +
+	"\x43\x81\x26\x04\x43\x83\x42\x10\x43\x84\x42\x04\x43\x89\x46\x02\x43\x8a\x24\x02\x43\x8c\x04\x11\x43\x8e\x42\x10\x43\x90\x22\x10" +
+	"\x43\xb7\x42\x02\x43\xbf\x42\x02\x43\xc0\x24\x02\x43\xc6\x22\x08\x43\xd1\x42\x02\x43\xd2\x42\x04\x43\xd8\x22\x04\x43\xda\x24\x02" +
+	"\x43\xdc\x42\x02\x43\xe0\x22\x01\x43\xf6\x42\x02\x44\x26\x42\x04\x44\x2c\x42\x02\x44\x2e\x42\x01\x44\x5c\x42\x04\x44\x62\x42\x02" +
+	"\x44\x64\x42\x01\x44\x74\x42\x40\x44\x76\x4a\x40\x44\x77\x42\x40\x44\x7c\x46\x40\x44\x7d\x42\x40\x44\x7f\x42\x40\x44\x81\x04\x70" +
+	"\x44\x83\x42\x40\x44\x89\x42\x40\x44\xaa\x46\x40\x44\xac\x2a\x40\x44\xad\x42\x40\x44\xb2\x42\x40\x44\xb3\x42\x40\x44\xb5\x42\x40" +
+	"\x44\xb7\x42\x40\x44\xb9\x42\x40\x44\xbf\x04\x68\x44\xc4\x42\x20\x44\xc5\x42\x40\x44\xc7\x42\x40\x44\xc9\x42\x20\x44\xcb\x42\x60" +
+	"\x44\xcd\x42\x40\x44\xcf\x42\x60\x44\xd2\x42\x40\x44\xd3\x42\x60\x44\xd4\x42\x40\x44\xd7\x42\x60\x44\xd9\x42\x20\x44\xda\x42\x60" +
+	"\x44\xe3\x42\x04\x44\xe9\x42\x42\x44\xeb\x42\x41\x45\x19\x42\x44\x45\x1f\x42\x42\x45\x21\x42\x01\x45\x4c\x4a\x40\x45\x4e\x4a\x40" +
+	"\x45\x4f\x42\x40\x45\x54\x08\x40\x45\x55\x42\x40\x45\x57\x42\x40\x45\x59\x04\x58\x45\x5b\x04\x58\x45\x61\x26\x40\x45\x66\x42\x10" +
+	"\x45\x67\x42\x40\x45\x69\x42\x40\x45\x6b\x42\x10\x45\x6d\x42\x50\x45\x6f\x42\x40\x45\x71\x42\x10\x45\x74\x42\x50\x45\x75\x42\x10" +
+	"\x45\x76\x42\x50\x45\x79\x42\x50\x45\x7b\x42\x10\x45\x7c\x42\x40\x45\x85\x42\x04\x45\x8b\x42\x02\x45\x8d\x42\x01\x45\x9c\x42\x08" +
+	"\x45\x9d\x42\x40\x45\x9f\x42\x40\x45\xa1\x42\x08\x45\xa3\x42\x08\x45\xa5\x42\x40\x45\xa7\x42\x48\x45\xaa\x42\x40\x45\xab\x42\x48" +
+	"\x45\xac\x42\x40\x45\xaf\x42\x08\x45\xb1\x42\x08\x45\xb2\x42\x40\x45\xd3\x26\x40\x45\xd5\x26\x40\x45\xd6\x42\x40\x45\xdb\x42\x40" +
+	"\x45\xdc\x42\x40\x45\xde\x42\x40\x45\xe0\x42\x40\x45\xe2\x42\x40\x45\xe8\x22\x40\x45\xf1\x42\x04\x45\xf7\x42\x02\x45\xf9\x42\x01" +
+	"\x46\x09\x42\x40\x46\x0b\x04\x45\x46\x0c\x42\x40\x46\x11\x04\x43\x46\x12\x42\x42\x46\x14\x42\x41\x46\x1e\x42\x40\x46\x5d\x42\x44" +
+	"\x46\x63\x42\x42\x46\x65\x42\x01\x46\x93\x42\x04\x46\x99\x42\x42\x46\x9b\x42\x41\x46\xab\x42\x40\x46\xad\x04\x45\x46\xae\x42\x44" +
+	"\x46\xb3\x42\x40\x46\xb4\x42\x40\x46\xb6\x42\x40\x46\xb8\x42\x40\x46\xba\x42\x40\x47\x32\x42\x08\x47\x34\x46\x05\x47\x35\x04\x3c" +
+	"\x47\x3a\x42\x10\x47\x3b\x04\x3a\x47\x3d\x04\x39\x47\x3f\x42\x10\x47\x41\x42\x18\x47\x47\x42\x08\x47\x4c\x42\x01\x47\x4f\x08\x01" +
+	"\x47\x51\x46\x10\x47\x55\x04\x33\x47\x57\x42\x10\x47\x5a\x42\x10\x47\x5b\x42\x11\x47\x61\x42\x01\x47\x6b\x42\x04\x47\x71\x42\x12" +
+	"\x47\x73\x42\x11\x47\x82\x42\x04\x47\x83\x04\x2e\x47\x85\x28\x04\x47\x87\x42\x04\x47\x89\x42\x0c\x47\x95\x42\x08\x47\x97\x26\x20" +
+	"\x47\x98\x42\x20\x47\x9d\x04\x27\x47\x9f\x42\x24\x47\xa2\x04\x24\x47\xa3\x42\x25\x47\xaf\x42\x21\x47\xb2\x42\x20\x47\xb4\x42\x20" +
+	"\x47\xb9\x42\x04\x47\xbb\x42\x04\x47\xbc\x24\x04\x47\xce\x22\x20\x47\xd7\x42\x0c\x47\xdd\x42\x0a\x47\xdf\x42\x01\x47\xf1\x42\x01" +
+	"\x47\xf7\x42\x01\x47\xfa\x42\x01\x48\x24\x46\x18\x48\x25\x08\x08\x48\x27\x08\x1d\x48\x29\x46\x10\x48\x2b\x42\x08\x48\x2d\x46\x10" +
+	"\x48\x2f\x42\x10\x48\x32\x42\x10\x48\x33\x42\x10\x48\x34\x42\x10\x48\x37\x42\x08\x48\x39\x46\x08\x48\x3a\x24\x08\x48\x3f\x04\x17" +
+	"\x48\x41\x42\x10\x48\x44\x42\x10\x48\x45\x42\x11\x48\x4a\x42\x10\x48\x4e\x42\x10\x48\x50\x42\x10\x48\x51\x42\x11\x48\x54\x04\x11" +
+	"\x48\x56\x42\x10\x48\x5b\x46\x04\x48\x5d\x46\x04\x48\x5e\x24\x04\x48\x63\x42\x10\x48\x64\x04\x12\x48\x66\x04\x11\x48\x68\x42\x10" +
+	"\x48\x6a\x42\x10\x48\x70\x22\x10\x48\x75\x04\x0f\x48\x77\x42\x0c\x48\x7a\x04\x0c\x48\x7b\x42\x0c\x48\x7c\x04\x0c\x48\x87\x42\x08" +
+	"\x48\x88\x24\x08\x48\x8a\x24\x08\x48\x8c\x42\x08\x48\x8e\x42\x08\x48\xab\x42\x04\x48\xac\x24\x04\x48\xae\x24\x04\x48\xb0\x42\x04" +
+	"\x48\xb2\x42\x04\x48\xbe\x22\x02\x48\xc0\x22\x01\x48\xc7\x42\x08\x48\xc9\x46\x01\x48\xca\x04\x0c\x48\xcf\x04\x0b\x48\xd0\x42\x02" +
+	"\x48\xd2\x42\x01\x48\xdc\x42\x08\x48\xe1\x42\x01\x48\xe4\x42\x01\x48\xea\x42\x03\x48\xf6\x42\x01\x49\x00\x42\x04\x49\x06\x42\x02" +
+	"\x49\x08\x42\x01\x49\x1b\x42\x0c\x49\x21\x42\x1a\x49\x23\x42\x11\x49\x35\x42\x01\x49\x3b\x42\x11\x49\x3e\x04\x11\x49\x40\x42\x10" +
+	"\x49\x69\x42\x0c\x49\x6b\x42\x04\x49\x6c\x42\x04\x49\x83\x42\x05\x49\x86\x42\x04\x49\x88\x42\x04\x49\xa2\x42\x04\x49\xe0\x42\x01"
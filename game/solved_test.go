@@ -0,0 +1,128 @@
+package game
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupSolved_MatchesSearch(t *testing.T) {
+	positions := make(map[string]TicTacToeState)
+	reachablePositions(TicTacToeState{Board: makeBoard(3), Turn: 1}, positions)
+	assert.Equal(t, len(positions), len(solvedTable)/solvedTableEntrySize)
+
+	memo := make(map[string]int)
+	for key, gameState := range positions {
+		p, ok := lookupSolved(gameState)
+		if !assert.True(t, ok, key) {
+			continue
+		}
+
+		s := &search{}
+		wantScore, wantX, wantY, _ := s.alphaBeta(gameState, true, 0, -math.MaxInt32, math.MaxInt32)
+		gotX, gotY := p.bestMove()
+		assert.Equal(t, wantScore, p.score(), key)
+		assert.Equal(t, wantX, gotX, key)
+		assert.Equal(t, wantY, gotY, key)
+
+		// Every move marked as best must be optimal, and every optimal move
+		// must be marked as best.
+		for move, child := range childPositions(gameState) {
+			bit := uint16(1) << uint(move[1]*3+move[0])
+			optimal := childScore(child, memo) == wantScore
+			assert.Equal(t, optimal, p.bestMoves&bit != 0, "%s %v", key, move)
+		}
+	}
+}
+
+func TestLookupSolved(t *testing.T) {
+	tests := []struct {
+		name        string
+		gameState   TicTacToeState
+		expOk       bool
+		expPosition solvedPosition
+	}{
+		{
+			name:      "Opening Move",
+			gameState: TicTacToeState{Board: makeBoard(3), Turn: 1},
+			expOk:     true,
+			expPosition: solvedPosition{
+				outcome:   outcomeDraw,
+				distance:  9,
+				bestMoves: 0x1ff,
+			},
+		},
+		{
+			name: "Naughts Win",
+			gameState: TicTacToeState{
+				Turn: 6,
+				Board: [][]SquareState{
+					{SquareStateCross, SquareStateEmpty, SquareStateEmpty},
+					{SquareStateCross, SquareStateCross, SquareStateNaught},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateNaught},
+				},
+			},
+			expOk: true,
+			expPosition: solvedPosition{
+				outcome:   outcomeWin,
+				distance:  1,
+				bestMoves: 1 << 2,
+			},
+		},
+		{
+			name: "Crosses Win When Naughts Open",
+			gameState: TicTacToeState{
+				FirstPlayer: SquareStateNaught,
+				Turn:        6,
+				Board: [][]SquareState{
+					{SquareStateNaught, SquareStateEmpty, SquareStateEmpty},
+					{SquareStateNaught, SquareStateNaught, SquareStateCross},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateCross},
+				},
+			},
+			expOk: true,
+			expPosition: solvedPosition{
+				outcome:   outcomeWin,
+				distance:  1,
+				bestMoves: 1 << 2,
+			},
+		},
+		{
+			name: "Unreachable",
+			gameState: TicTacToeState{
+				Turn: 4,
+				Board: [][]SquareState{
+					{SquareStateNaught, SquareStateEmpty, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateNaught, SquareStateCross},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				},
+			},
+			expOk: false,
+		},
+		{
+			name:      "Four by four",
+			gameState: TicTacToeState{Board: makeBoard(4), WinLength: 3, Turn: 1},
+			expOk:     false,
+		},
+		{
+			name: "Game over",
+			gameState: TicTacToeState{
+				Turn: 6,
+				Board: [][]SquareState{
+					{SquareStateCross, SquareStateCross, SquareStateCross},
+					{SquareStateNaught, SquareStateNaught, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				},
+			},
+			expOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, ok := lookupSolved(tt.gameState)
+			assert.Equal(t, tt.expOk, ok)
+			assert.Equal(t, tt.expPosition, p)
+		})
+	}
+}
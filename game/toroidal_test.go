@@ -0,0 +1,190 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// wrappedDiagonalBoard holds crosses on a diagonal that runs off the right
+// edge of the board and carries on from the left.
+func wrappedDiagonalBoard() [][]SquareState {
+	return [][]SquareState{
+		{SquareStateEmpty, SquareStateCross, SquareStateNaught},
+		{SquareStateNaught, SquareStateEmpty, SquareStateCross},
+		{SquareStateCross, SquareStateEmpty, SquareStateEmpty},
+	}
+}
+
+func TestToroidal_GetGameResult(t *testing.T) {
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		want      Result
+		wantRow   [][]SquareState
+	}{
+		{
+			name:      "Wrapped diagonal",
+			gameState: TicTacToeState{Board: wrappedDiagonalBoard(), Variant: VariantToroidal, Turn: 6},
+			want:      ResultNInARow,
+			wantRow: [][]SquareState{
+				{SquareStateEmpty, SquareStateCross, SquareStateEmpty},
+				{SquareStateEmpty, SquareStateEmpty, SquareStateCross},
+				{SquareStateCross, SquareStateEmpty, SquareStateEmpty},
+			},
+		},
+		{
+			name:      "Wrapped diagonal on a flat board",
+			gameState: TicTacToeState{Board: wrappedDiagonalBoard(), Turn: 6},
+			want:      ResultNone,
+		},
+		{
+			name: "Wrapped row",
+			gameState: TicTacToeState{
+				Board: [][]SquareState{
+					{SquareStateCross, SquareStateCross, SquareStateEmpty, SquareStateCross},
+					{SquareStateNaught, SquareStateNaught, SquareStateEmpty, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				},
+				WinLength: 3,
+				Variant:   VariantToroidal,
+				Turn:      6,
+			},
+			want: ResultNInARow,
+			wantRow: [][]SquareState{
+				{SquareStateCross, SquareStateCross, SquareStateEmpty, SquareStateCross},
+				{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+			},
+		},
+		{
+			name: "Whole row without overlines",
+			gameState: TicTacToeState{
+				Board: [][]SquareState{
+					{SquareStateCross, SquareStateCross, SquareStateCross},
+					{SquareStateNaught, SquareStateNaught, SquareStateEmpty},
+					{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				},
+				NoOverlines: true,
+				Variant:     VariantToroidal,
+				Turn:        6,
+			},
+			want: ResultNInARow,
+			wantRow: [][]SquareState{
+				{SquareStateCross, SquareStateCross, SquareStateCross},
+				{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotRow := tt.gameState.getGameResult()
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantRow, gotRow)
+		})
+	}
+}
+
+func TestToroidal_MoveResult(t *testing.T) {
+	// Every square of the wrapped diagonal completes it.
+	for _, square := range [][2]int{{1, 0}, {2, 1}, {0, 2}} {
+		gs := TicTacToeState{Board: wrappedDiagonalBoard(), Variant: VariantToroidal, Turn: 6}
+		result, _ := gs.moveResult(square[0], square[1])
+		assert.Equal(t, ResultNInARow, result, "square %v", square)
+	}
+
+	// A run of pieces all the way round a larger board is still found.
+	gs := TicTacToeState{Board: makeBoard(4), WinLength: 4, NoOverlines: true, Variant: VariantToroidal, Turn: 5}
+	for x := range gs.Board[2] {
+		gs.Board[2][x] = SquareStateCross
+	}
+	result, _ := gs.moveResult(1, 2)
+	assert.Equal(t, ResultNInARow, result)
+}
+
+func TestToroidal_ComputeMove(t *testing.T) {
+	// Crosses win only across the edge, at 0, 2.
+	gameState := TicTacToeState{
+		Board: [][]SquareState{
+			{SquareStateNaught, SquareStateCross, SquareStateNaught},
+			{SquareStateNaught, SquareStateEmpty, SquareStateCross},
+			{SquareStateEmpty, SquareStateEmpty, SquareStateCross},
+		},
+		Variant: VariantToroidal,
+		Turn:    7,
+	}
+	for _, name := range Engines() {
+		if name == EngineRandom {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			engine, err := NewEngine(name, EngineOptions{Iterations: 2000, Rand: rand.New(rand.NewSource(1))})
+			assert.NoError(t, err)
+			move, _, err := engine.ComputeMove(context.Background(), gameState)
+			assert.NoError(t, err)
+			assert.Equal(t, Move{X: 0, Y: 2}, move)
+		})
+	}
+}
+
+func TestToroidal_FirstPlayerWins(t *testing.T) {
+	gameState := TicTacToeState{Board: makeBoard(3), Variant: VariantToroidal, Turn: 1}
+	score, _, solved, err := deepen(context.Background(), gameState, 0)
+	assert.NoError(t, err)
+	assert.True(t, solved)
+	assert.True(t, score > scoreWin/2)
+}
+
+func TestToroidalStateHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+		expResult     Result
+		expWinner     rune
+	}{
+		{
+			name:          "Computer completes a wrapped diagonal",
+			body:          `{"variant": "toroidal", "board": [[48,88,48],[48,0,88],[0,0,88]]}`,
+			expStatusCode: http.StatusOK,
+			expResult:     ResultNInARow,
+			expWinner:     rune(SquareStateCross),
+		},
+		{
+			name:          "Empty board",
+			body:          `{"variant": "toroidal", "size": 4, "timeBudget": 50}`,
+			expStatusCode: http.StatusOK,
+		},
+		{
+			name:          "Win length longer than the board",
+			body:          `{"variant": "toroidal", "size": 3, "winLength": 4}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			TicTacToeStateHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+			if tt.expStatusCode != http.StatusOK {
+				return
+			}
+
+			resp := TicTacToeStateResponse{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expResult, resp.Result)
+			assert.Equal(t, tt.expWinner, resp.Winner)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package game
+
+import "sync"
+
+// symmetries is the number of rotations and reflections of a square board.
+const symmetries = 8
+
+// FNV-1a parameters used to hash boards.
+const (
+	fnvOffset64 uint64 = 14695981039346656037
+	fnvPrime64  uint64 = 1099511628211
+)
+
+// transpositionTableSize is the number of entries held by the table shared by
+// all requests.
+const transpositionTableSize = 1 << 16
+
+// transpositions caches search results across requests.
+var transpositions = newTranspositionTable(transpositionTableSize)
+
+type bound int
+
+const (
+	boundExact bound = iota
+	boundLower
+	boundUpper
+)
+
+// transposition is the result of searching a position. The draft is the
+// number of plies it was searched to, or solvedDraft if the search reached the
+// end of the game.
+type transposition struct {
+	key   uint64
+	score int
+	bound bound
+	draft int
+	valid bool
+}
+
+// transpositionTable is a fixed size cache of search results keyed by the
+// canonical hash of a board. When two boards hash to the same slot the most
+// recent result replaces the older one, so the table never grows. It is safe
+// for concurrent use.
+type transpositionTable struct {
+	mu      sync.Mutex
+	entries []transposition
+}
+
+func newTranspositionTable(size int) *transpositionTable {
+	return &transpositionTable{
+		entries: make([]transposition, size),
+	}
+}
+
+func (t *transpositionTable) load(key uint64) (transposition, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	e := t.entries[key%uint64(len(t.entries))]
+	if !e.valid || e.key != key {
+		return transposition{}, false
+	}
+
+	return e, true
+}
+
+func (t *transpositionTable) store(key uint64, score int, b bound, draft int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.entries[key%uint64(len(t.entries))] = transposition{
+		key:   key,
+		score: score,
+		bound: b,
+		draft: draft,
+		valid: true,
+	}
+}
+
+// transformSquare maps the coordinates of a square on an n by n board onto
+// one of its eight rotations and reflections.
+func transformSquare(symmetry, x, y, n int) (int, int) {
+	switch symmetry {
+	case 1:
+		return n - 1 - y, x
+	case 2:
+		return n - 1 - x, n - 1 - y
+	case 3:
+		return y, n - 1 - x
+	case 4:
+		return n - 1 - x, y
+	case 5:
+		return x, n - 1 - y
+	case 6:
+		return y, x
+	case 7:
+		return n - 1 - y, n - 1 - x
+	}
+
+	return x, y
+}
+
+// canonicalHash hashes the board and the rules it is played by such that all
+// rotations and reflections of a board share the same hash. Squares are hashed
+// by whether they hold the first or the second player's piece, so that boards
+// that only differ by which player moved first share the same hash too. Whose
+// turn it is and the number of blocked squares and handicap pieces are hashed
+// as well, since with a handicap the pieces on the board no longer tell them.
+// In the gravity variant, where pieces fall to the bottom of the board, the
+// only symmetry is the reflection between left and right.
+func (t *TicTacToeState) canonicalHash() uint64 {
+	width := 0
+	if len(t.Board) > 0 {
+		width = len(t.Board[0])
+	}
+	var canonical uint64
+	for s := 0; s < symmetries; s++ {
+		if t.variant() == VariantGravity && s != 0 && s != 4 {
+			continue
+		}
+		h := fnvOffset64
+		h ^= uint64(t.winLength()) | uint64(width)<<40
+		if t.NoOverlines {
+			h ^= 1 << 32
+		}
+		h *= fnvPrime64
+		h ^= uint64(t.Turn%2) | uint64(t.setup)<<1
+		h *= fnvPrime64
+		for i := 0; i < len(t.variant()); i++ {
+			h ^= uint64(t.variant()[i])
+			h *= fnvPrime64
+		}
+		for y := 0; y < len(t.Board); y++ {
+			for x := 0; x < width; x++ {
+				tx, ty := transformSquare(s, x, y, width)
+				h ^= uint64(t.hashSquare(t.Board[ty][tx]))
+				h *= fnvPrime64
+			}
+		}
+		if s == 0 || h < canonical {
+			canonical = h
+		}
+	}
+
+	return canonical
+}
+
+// hashSquare returns the value canonicalHash hashes a square by: the order of
+// the player whose piece it holds or, when the players share symbols, which
+// then belong to neither of them, the symbol itself. Blocked squares hash
+// apart from both.
+func (t *TicTacToeState) hashSquare(s SquareState) int {
+	if s == SquareStateBlocked {
+		return 3
+	}
+	if !t.sharesSymbols() {
+		return t.order(s)
+	}
+	switch s {
+	case SquareStateCross:
+		return 1
+	case SquareStateNaught:
+		return 2
+	}
+
+	return 0
+}
+
+// toTranspositionScore converts a score relative to the root of the search
+// into one relative to the node at depth so that it can be reused wherever
+// the position recurs in the tree.
+func toTranspositionScore(score, depth int) int {
+	if !isWinScore(score) {
+		return score
+	} else if score > 0 {
+		return score + depth
+	}
+
+	return score - depth
+}
+
+// fromTranspositionScore is the inverse of toTranspositionScore.
+func fromTranspositionScore(score, depth int) int {
+	if !isWinScore(score) {
+		return score
+	} else if score > 0 {
+		return score - depth
+	}
+
+	return score + depth
+}
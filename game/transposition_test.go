@@ -0,0 +1,102 @@
+package game
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalHash(t *testing.T) {
+	board := [][]SquareState{
+		{SquareStateCross, SquareStateNaught, SquareStateEmpty},
+		{SquareStateEmpty, SquareStateCross, SquareStateEmpty},
+		{SquareStateEmpty, SquareStateEmpty, SquareStateNaught},
+	}
+	g := &TicTacToeState{Board: board}
+	want := g.canonicalHash()
+
+	for s := 0; s < symmetries; s++ {
+		transformed := makeBoard(3)
+		for y := range board {
+			for x := range board[y] {
+				tx, ty := transformSquare(s, x, y, 3)
+				transformed[ty][tx] = board[y][x]
+			}
+		}
+		tg := &TicTacToeState{Board: transformed}
+		assert.Equal(t, want, tg.canonicalHash(), "symmetry %d", s)
+	}
+
+	other := &TicTacToeState{Board: [][]SquareState{
+		{SquareStateCross, SquareStateEmpty, SquareStateNaught},
+		{SquareStateEmpty, SquareStateCross, SquareStateEmpty},
+		{SquareStateEmpty, SquareStateEmpty, SquareStateNaught},
+	}}
+	assert.NotEqual(t, want, other.canonicalHash())
+}
+
+func TestTranspositionTable_LoadStore(t *testing.T) {
+	table := newTranspositionTable(4)
+	_, ok := table.load(1)
+	assert.False(t, ok)
+
+	table.store(1, 42, boundLower, solvedDraft)
+	e, ok := table.load(1)
+	assert.True(t, ok)
+	assert.Equal(t, 42, e.score)
+	assert.Equal(t, boundLower, e.bound)
+
+	// Keys sharing a slot replace each other rather than growing the table.
+	table.store(5, 7, boundExact, 3)
+	_, ok = table.load(1)
+	assert.False(t, ok)
+	e, ok = table.load(5)
+	assert.True(t, ok)
+	assert.Equal(t, 7, e.score)
+	assert.Equal(t, 3, e.draft)
+	assert.Len(t, table.entries, 4)
+}
+
+func TestTranspositionTable_ReducesNodes(t *testing.T) {
+	gameState := TicTacToeState{Board: makeBoard(3), Turn: 1}
+
+	without := &search{}
+	wantScore, wantX, wantY, _ := without.alphaBeta(gameState, true, 0, -math.MaxInt32, math.MaxInt32)
+
+	with := &search{table: newTranspositionTable(transpositionTableSize)}
+	score, x, y, _ := with.alphaBeta(gameState, true, 0, -math.MaxInt32, math.MaxInt32)
+
+	t.Logf("nodes searched without table: %d, with table: %d", without.nodes, with.nodes)
+	assert.Equal(t, wantScore, score)
+	assert.Equal(t, wantX, x)
+	assert.Equal(t, wantY, y)
+	assert.Less(t, with.nodes*4, without.nodes)
+
+	// A second search of the same position is answered from the table, so
+	// only the root and its children are visited.
+	again := &search{table: with.table}
+	again.alphaBeta(gameState, true, 0, -math.MaxInt32, math.MaxInt32)
+	assert.LessOrEqual(t, again.nodes, 10)
+}
+
+func TestDeepen_Concurrent(t *testing.T) {
+	positions := make(map[string]TicTacToeState)
+	reachablePositions(TicTacToeState{Board: makeBoard(3), Turn: 1}, positions)
+
+	memo := make(map[string]int)
+	var wg sync.WaitGroup
+	for key, gameState := range positions {
+		want := solvePosition(gameState, memo)
+		wg.Add(1)
+		go func(key string, gameState TicTacToeState, want int) {
+			defer wg.Done()
+			got, _, _, err := deepen(context.Background(), gameState, 0)
+			assert.NoError(t, err, key)
+			assert.Equal(t, want, got, key)
+		}(key, gameState, want)
+	}
+	wg.Wait()
+}
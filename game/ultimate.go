@@ -0,0 +1,347 @@
+package game
+
+import (
+	"encoding/json"
+	"log"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// ultimateSubSize is the length of each side of an Ultimate sub-board, and
+// the number of sub-boards along each side of the whole board.
+const ultimateSubSize = 3
+
+// ultimateSize is the length of each side of the whole Ultimate board.
+const ultimateSize = ultimateSubSize * ultimateSubSize
+
+// UltimateState is a 9x9 board made up of nine 3x3 sub-boards. A player wins
+// a sub-board by completing a line on it and the game by winning three
+// sub-boards in a line. The square a player occupies within its sub-board
+// decides the sub-board their opponent must play on next, unless that
+// sub-board is already won or full, in which case the opponent may play on
+// any sub-board still in play. LastMove is the square occupied on the
+// previous turn.
+type UltimateState struct {
+	Board       [][]SquareState `json:"board"`
+	LastMove    *Move           `json:"lastMove,omitempty"`
+	Variant     Variant         `json:"variant"`
+	FirstPlayer SquareState     `json:"firstPlayer,omitempty"`
+	HumanPlayer SquareState     `json:"humanPlayer,omitempty"`
+	Iterations  int             `json:"iterations,omitempty"`
+	TimeBudget  int             `json:"timeBudget,omitempty"` // milliseconds
+	Seed        int64           `json:"seed,omitempty"`
+	Turn        int             `json:"-"`
+}
+
+// UltimateStateResponse describes the whole board along with the meta-board
+// of the sub-boards' winners, on which WinningRow lies.
+type UltimateStateResponse struct {
+	Board      [][]SquareState `json:"board"`
+	MetaBoard  [][]SquareState `json:"metaBoard"`
+	Result     Result          `json:"result,omitempty"`
+	WinningRow [][]SquareState `json:"winningRow,omitempty"`
+	LastMove   *Move           `json:"lastMove,omitempty"`
+	Turn       int             `json:"turn"`
+	NextPlayer rune            `json:"nextPlayer"`
+}
+
+// ultimateStateHandler serves game state requests for the Ultimate variant,
+// whose body b holds an UltimateState, responding with an
+// UltimateStateResponse after the computer's move. The computer plays a Monte
+// Carlo tree search.
+func ultimateStateHandler(w http.ResponseWriter, r *http.Request, b []byte) {
+	req := &UltimateState{}
+	err := json.Unmarshal(b, req)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "could not interpret request", err)
+		return
+	}
+
+	err = req.initialize()
+	if err != nil {
+		writeHTTPError(w, errorStatus(err), "invalid game settings", err)
+		return
+	}
+	if req.Iterations < 0 || req.TimeBudget < 0 {
+		writeHTTPError(w, errorStatus(ErrInvalidSearchLimit), "invalid engine", ErrInvalidSearchLimit)
+		return
+	}
+
+	result, _ := req.getGameResult()
+	if result == ResultNone && SquareState(req.playersTurn()) == req.HumanPlayer {
+		writeHTTPError(w, errorStatus(ErrNotComputersTurn), "invalid game state", ErrNotComputersTurn)
+		return
+	}
+	if result == ResultNone {
+		seed := req.Seed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		engine := &mctsEngine{
+			iterations: req.Iterations,
+			budget:     time.Duration(req.TimeBudget) * time.Millisecond,
+			rng:        rand.New(rand.NewSource(seed)),
+		}
+		if engine.iterations == 0 && engine.budget == 0 {
+			engine.budget = defaultTimeBudget
+		}
+		_, x, y := engine.computeMove(r.Context(), req.clone())
+		err = req.occupyPosition(x, y)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, "failed to set board", err)
+			return
+		}
+	}
+
+	result, winningRow := req.getGameResult()
+	metaBoard := req.metaBoard()
+	resp := UltimateStateResponse{
+		Board:      req.Board,
+		MetaBoard:  metaBoard.Board,
+		Result:     result,
+		WinningRow: winningRow,
+		LastMove:   req.LastMove,
+		Turn:       req.Turn,
+		NextPlayer: req.playersTurn(),
+	}
+
+	b, err = json.Marshal(resp)
+	if err != nil {
+		writeHTTPError(w, http.StatusInternalServerError, "failed to marshal response", err)
+		return
+	}
+
+	_, err = w.Write(b)
+	if err != nil {
+		log.Print(err)
+	}
+}
+
+// initialize prepares an Ultimate state received in a request, creating an
+// empty board when no board was given, and checking that the board is 9x9,
+// holds nothing but the players' pieces, that the players have taken turns,
+// that the last move was made by the player who moved last and that play could
+// have led to the board.
+func (u *UltimateState) initialize() error {
+	if len(u.Board) == 0 {
+		u.Board = makeBoard(ultimateSize)
+	}
+	if len(u.Board) != ultimateSize {
+		return ErrInvalidBoardSize
+	}
+	for _, row := range u.Board {
+		if len(row) != ultimateSize {
+			return ErrInvalidBoardSize
+		}
+	}
+	if !isPlayer(u.FirstPlayer) && u.FirstPlayer != SquareStateEmpty ||
+		!isPlayer(u.HumanPlayer) && u.HumanPlayer != SquareStateEmpty {
+		return ErrInvalidPlayer
+	}
+
+	first, second := 0, 0
+	for _, row := range u.Board {
+		for _, square := range row {
+			switch square {
+			case opener(u.FirstPlayer):
+				first++
+			case opponent(opener(u.FirstPlayer)):
+				second++
+			case SquareStateEmpty:
+			default:
+				return ErrInvalidSymbol
+			}
+		}
+	}
+	if first != second && first != second+1 {
+		return ErrTurnImbalance
+	}
+	u.Turn = first + second + 1
+
+	if u.Turn == 1 {
+		if u.LastMove != nil {
+			return ErrInvalidLastMove
+		}
+		return nil
+	}
+	last := u.LastMove
+	if last == nil || last.X < 0 || last.X >= ultimateSize || last.Y < 0 || last.Y >= ultimateSize ||
+		u.Board[last.Y][last.X] != opponent(SquareState(u.playersTurn())) {
+		return ErrInvalidLastMove
+	}
+
+	// Play moves on from a sub-board once it is won, and the game ends with
+	// a line of sub-boards won by the player who moved last.
+	for by := 0; by < ultimateSubSize; by++ {
+		for bx := 0; bx < ultimateSubSize; bx++ {
+			sub := u.subBoard(bx, by)
+			if len(sub.linePlayers()) > 1 {
+				return ErrUnreachablePosition
+			}
+		}
+	}
+	meta := u.metaBoard()
+	for player := range meta.linePlayers() {
+		if player != opponent(SquareState(u.playersTurn())) {
+			return ErrUnreachablePosition
+		}
+	}
+
+	return nil
+}
+
+func (u *UltimateState) clone() *UltimateState {
+	gs := &UltimateState{
+		Board:       copyBoard(u.Board),
+		FirstPlayer: u.FirstPlayer,
+		Turn:        u.Turn,
+	}
+	if u.LastMove != nil {
+		last := *u.LastMove
+		gs.LastMove = &last
+	}
+
+	return gs
+}
+
+func (u *UltimateState) playersTurn() rune {
+	first := opener(u.FirstPlayer)
+	if u.Turn%2 == 1 {
+		return rune(first)
+	}
+
+	return rune(opponent(first))
+}
+
+// subBoard returns the sub-board in column bx and row by of the meta-board as
+// a game of its own, sharing its squares with the whole board.
+func (u *UltimateState) subBoard(bx, by int) TicTacToeState {
+	sub := TicTacToeState{Board: make([][]SquareState, ultimateSubSize), Turn: 1}
+	for y := range sub.Board {
+		sub.Board[y] = u.Board[by*ultimateSubSize+y][bx*ultimateSubSize : (bx+1)*ultimateSubSize]
+		for _, square := range sub.Board[y] {
+			if square != SquareStateEmpty {
+				sub.Turn++
+			}
+		}
+	}
+
+	return sub
+}
+
+// metaBoard returns the game played on the sub-boards, each square of which
+// holds the winner of its sub-board. Its turn counts the finished sub-boards,
+// so that it is a stalemate once every sub-board is won or full.
+func (u *UltimateState) metaBoard() TicTacToeState {
+	meta := TicTacToeState{Board: makeBoard(ultimateSubSize), Turn: 1}
+	for by := 0; by < ultimateSubSize; by++ {
+		for bx := 0; bx < ultimateSubSize; bx++ {
+			sub := u.subBoard(bx, by)
+			result, winningRow := sub.getGameResult()
+			if result == ResultNone {
+				continue
+			}
+			meta.Turn++
+			if result == ResultNInARow {
+				meta.Board[by][bx] = SquareState(linePlayer(winningRow))
+			}
+		}
+	}
+
+	return meta
+}
+
+// getGameResult calculates the current state of the game from the meta-board
+// returning the result and the row of sub-boards that concluded the game if
+// there is one, nil otherwise.
+func (u *UltimateState) getGameResult() (Result, [][]SquareState) {
+	meta := u.metaBoard()
+	return meta.getGameResult()
+}
+
+// legalMoves returns the squares the player whose turn it is may occupy: the
+// empty squares of the sub-board the last move sends them to or, when that
+// sub-board is finished, of every sub-board still in play.
+func (u *UltimateState) legalMoves() [][2]int {
+	if u.LastMove != nil {
+		bx, by := u.LastMove.X%ultimateSubSize, u.LastMove.Y%ultimateSubSize
+		if squares := u.subBoardMoves(bx, by); squares != nil {
+			return squares
+		}
+	}
+
+	var squares [][2]int
+	for by := 0; by < ultimateSubSize; by++ {
+		for bx := 0; bx < ultimateSubSize; bx++ {
+			squares = append(squares, u.subBoardMoves(bx, by)...)
+		}
+	}
+
+	return squares
+}
+
+// subBoardMoves returns the empty squares of a sub-board, or nil when the
+// sub-board is finished.
+func (u *UltimateState) subBoardMoves(bx, by int) [][2]int {
+	sub := u.subBoard(bx, by)
+	if result, _ := sub.getGameResult(); result != ResultNone {
+		return nil
+	}
+
+	var squares [][2]int
+	for _, square := range sub.emptySquares() {
+		squares = append(squares, [2]int{bx*ultimateSubSize + square[0], by*ultimateSubSize + square[1]})
+	}
+
+	return squares
+}
+
+func (u *UltimateState) occupyPosition(x, y int) error {
+	if x < 0 || x >= ultimateSize || y < 0 || y >= ultimateSize {
+		return ErrOutOfBounds
+	}
+	if u.Board[y][x] != SquareStateEmpty {
+		return ErrOccupied
+	}
+	legal := false
+	for _, move := range u.legalMoves() {
+		if move[0] == x && move[1] == y {
+			legal = true
+			break
+		}
+	}
+	if !legal {
+		return ErrIllegalMove
+	}
+
+	u.Board[y][x] = SquareState(u.playersTurn())
+	u.LastMove = &Move{X: x, Y: y}
+	u.Turn++
+
+	return nil
+}
+
+// outcome reports whether the game is over and if so the winner, or zero for
+// a stalemate.
+func (u *UltimateState) outcome() (bool, rune) {
+	result, winningRow := u.getGameResult()
+	switch result {
+	case ResultNInARow:
+		return true, linePlayer(winningRow)
+	case ResultStalemate:
+		return true, 0
+	}
+
+	return false, 0
+}
+
+// outcomeAfter is outcome, which for the meta-board is no quicker to work out
+// from the last move.
+func (u *UltimateState) outcomeAfter(x, y int) (bool, rune) {
+	return u.outcome()
+}
+
+func (u *UltimateState) copyPosition() position {
+	return u.clone()
+}
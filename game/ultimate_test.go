@@ -0,0 +1,353 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// makeUltimateState returns a board holding crosses and naughts on the given
+// squares, each given as x, y, with the last move made on last.
+func makeUltimateState(crosses, naughts [][2]int, last *Move) UltimateState {
+	u := UltimateState{Board: makeBoard(ultimateSize), LastMove: last}
+	for _, s := range crosses {
+		u.Board[s[1]][s[0]] = SquareStateCross
+	}
+	for _, s := range naughts {
+		u.Board[s[1]][s[0]] = SquareStateNaught
+	}
+	u.Turn = len(crosses) + len(naughts) + 1
+
+	return u
+}
+
+// drawnUltimateBoard returns a full board on which every sub-board is drawn.
+func drawnUltimateBoard() [][]SquareState {
+	draw := [][]SquareState{
+		{SquareStateCross, SquareStateNaught, SquareStateCross},
+		{SquareStateCross, SquareStateNaught, SquareStateNaught},
+		{SquareStateNaught, SquareStateCross, SquareStateCross},
+	}
+	board := makeBoard(ultimateSize)
+	for y := range board {
+		for x := range board[y] {
+			board[y][x] = draw[y%ultimateSubSize][x%ultimateSubSize]
+		}
+	}
+
+	return board
+}
+
+func TestUltimate_Initialize(t *testing.T) {
+	// Crosses win the top row of sub-boards with a row in each, while
+	// naughts hold the middle rows of the sub-boards below without a line.
+	var crosses, naughts [][2]int
+	for b := 0; b < ultimateSubSize; b++ {
+		for i := 0; i < ultimateSubSize; i++ {
+			crosses = append(crosses, [2]int{b*ultimateSubSize + i, 0})
+		}
+		naughts = append(naughts, [2]int{b * ultimateSubSize, 4}, [2]int{b*ultimateSubSize + 1, 4}, [2]int{b * ultimateSubSize, 7})
+	}
+	tests := []struct {
+		name   string
+		state  UltimateState
+		expErr error
+	}{
+		{
+			name:  "Crosses win with their last move",
+			state: makeUltimateState(crosses, naughts[:8], &Move{X: 8, Y: 0}),
+		},
+		{
+			name:   "Crosses win on crosses' turn",
+			state:  makeUltimateState(crosses, naughts, &Move{X: 6, Y: 7}),
+			expErr: ErrUnreachablePosition,
+		},
+		{
+			name: "Sub-board with lines for both players",
+			state: makeUltimateState([][2]int{{0, 0}, {1, 0}, {2, 0}},
+				[][2]int{{0, 1}, {1, 1}, {2, 1}}, &Move{X: 2, Y: 1}),
+			expErr: ErrUnreachablePosition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.state.initialize()
+			assert.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
+
+func TestUltimate_LegalMoves(t *testing.T) {
+	// Squares of the sub-board in column bx and row by except those given.
+	subBoard := func(bx, by int, except ...[2]int) [][2]int {
+		var squares [][2]int
+		for y := by * 3; y < by*3+3; y++ {
+			for x := bx * 3; x < bx*3+3; x++ {
+				squares = append(squares, [2]int{x, y})
+			}
+		}
+		for _, e := range except {
+			for i, s := range squares {
+				if s == e {
+					squares = append(squares[:i], squares[i+1:]...)
+					break
+				}
+			}
+		}
+		return squares
+	}
+
+	tests := []struct {
+		name      string
+		gameState UltimateState
+		expMoves  [][2]int
+		expCount  int
+	}{
+		{
+			name:      "Opening",
+			gameState: makeUltimateState(nil, nil, nil),
+			expCount:  81,
+		},
+		{
+			name:      "Sent to the centre",
+			gameState: makeUltimateState([][2]int{{4, 4}}, nil, &Move{X: 4, Y: 4}),
+			expMoves:  subBoard(1, 1, [2]int{4, 4}),
+		},
+		{
+			name: "Sent to a corner",
+			gameState: makeUltimateState(
+				[][2]int{{4, 4}}, [][2]int{{5, 5}}, &Move{X: 5, Y: 5},
+			),
+			expMoves: subBoard(2, 2),
+		},
+		{
+			name: "Sent to a won sub-board",
+			gameState: makeUltimateState(
+				[][2]int{{0, 0}, {1, 0}, {2, 0}},
+				[][2]int{{3, 0}, {0, 3}},
+				&Move{X: 0, Y: 3},
+			),
+			expCount: 70,
+		},
+		{
+			name: "Sent to a full sub-board",
+			gameState: func() UltimateState {
+				u := makeUltimateState(nil, nil, &Move{X: 4, Y: 0})
+				draw := drawnUltimateBoard()
+				for y := 0; y < 3; y++ {
+					copy(u.Board[y][3:6], draw[y][3:6])
+				}
+				return u
+			}(),
+			expCount: 72,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			moves := tt.gameState.legalMoves()
+			if tt.expMoves == nil {
+				assert.Len(t, moves, tt.expCount)
+				return
+			}
+			sort.Slice(moves, func(i, j int) bool {
+				return moves[i][1] < moves[j][1] || moves[i][1] == moves[j][1] && moves[i][0] < moves[j][0]
+			})
+			assert.Equal(t, tt.expMoves, moves)
+		})
+	}
+}
+
+func TestUltimate_OccupyPosition(t *testing.T) {
+	tests := []struct {
+		name        string
+		gameState   UltimateState
+		x, y        int
+		expErr      error
+		expLastMove *Move
+	}{
+		{
+			name:        "Opening",
+			gameState:   makeUltimateState(nil, nil, nil),
+			x:           4,
+			y:           4,
+			expLastMove: &Move{X: 4, Y: 4},
+		},
+		{
+			name:        "Sent sub-board",
+			gameState:   makeUltimateState([][2]int{{4, 4}}, nil, &Move{X: 4, Y: 4}),
+			x:           3,
+			y:           5,
+			expLastMove: &Move{X: 3, Y: 5},
+		},
+		{
+			name:        "Wrong sub-board",
+			gameState:   makeUltimateState([][2]int{{4, 4}}, nil, &Move{X: 4, Y: 4}),
+			x:           0,
+			y:           0,
+			expErr:      ErrIllegalMove,
+			expLastMove: &Move{X: 4, Y: 4},
+		},
+		{
+			name:      "Out of bounds",
+			gameState: makeUltimateState(nil, nil, nil),
+			x:         9,
+			y:         0,
+			expErr:    ErrOutOfBounds,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.gameState.occupyPosition(tt.x, tt.y)
+			assert.ErrorIs(t, err, tt.expErr)
+			assert.Equal(t, tt.expLastMove, tt.gameState.LastMove)
+		})
+	}
+}
+
+func TestUltimate_GetGameResult(t *testing.T) {
+	tests := []struct {
+		name          string
+		gameState     UltimateState
+		want          Result
+		expWinningRow [][]SquareState
+	}{
+		{
+			name:      "Empty",
+			gameState: makeUltimateState(nil, nil, nil),
+			want:      ResultNone,
+		},
+		{
+			name: "Sub-board won",
+			gameState: makeUltimateState(
+				[][2]int{{0, 0}, {1, 0}, {2, 0}},
+				[][2]int{{3, 0}, {0, 3}},
+				&Move{X: 0, Y: 3},
+			),
+			want: ResultNone,
+		},
+		{
+			name: "Top row of sub-boards",
+			gameState: makeUltimateState(
+				[][2]int{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {4, 0}, {5, 0}, {6, 0}, {7, 0}, {8, 0}},
+				[][2]int{{0, 3}, {1, 3}, {2, 3}, {3, 3}, {4, 3}, {5, 3}, {6, 3}, {7, 3}},
+				&Move{X: 8, Y: 0},
+			),
+			want: ResultNInARow,
+			expWinningRow: [][]SquareState{
+				{SquareStateCross, SquareStateCross, SquareStateCross},
+				{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+				{SquareStateEmpty, SquareStateEmpty, SquareStateEmpty},
+			},
+		},
+		{
+			name:      "Stalemate",
+			gameState: UltimateState{Board: drawnUltimateBoard(), Turn: 82},
+			want:      ResultStalemate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotWinningRow := tt.gameState.getGameResult()
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.expWinningRow, gotWinningRow)
+		})
+	}
+}
+
+func TestUltimate_ComputeMove(t *testing.T) {
+	// Crosses have won the top left and top middle sub-boards and are sent
+	// to the top right, where they can complete the top row.
+	gameState := makeUltimateState(
+		[][2]int{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {4, 0}, {5, 0}, {6, 0}, {7, 0}},
+		[][2]int{{2, 3}, {0, 4}, {1, 5}, {0, 8}, {4, 4}, {3, 8}, {6, 6}, {8, 8}},
+		&Move{X: 2, Y: 3},
+	)
+	m := &mctsEngine{iterations: 2000, rng: rand.New(rand.NewSource(1))}
+	winRate, x, y := m.computeMove(context.Background(), &gameState)
+	assert.Equal(t, 8, x)
+	assert.Equal(t, 0, y)
+	assert.Equal(t, 1.0, winRate)
+}
+
+func TestUltimateStateHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+		expTurn       int
+	}{
+		{
+			name:          "Opening",
+			body:          `{"variant": "ultimate", "iterations": 200, "seed": 1}`,
+			expStatusCode: http.StatusOK,
+			expTurn:       2,
+		},
+		{
+			name: "Reply",
+			body: `{"variant": "ultimate", "iterations": 200, "seed": 1, "lastMove": {"x": 4, "y": 4}, "board": ` +
+				`[[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],` +
+				`[0,0,0,0,0,0,0,0,0],[0,0,0,0,88,0,0,0,0],[0,0,0,0,0,0,0,0,0],` +
+				`[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0]]}`,
+			expStatusCode: http.StatusOK,
+			expTurn:       3,
+		},
+		{
+			name: "Missing last move",
+			body: `{"variant": "ultimate", "board": ` +
+				`[[88,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],` +
+				`[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],` +
+				`[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0]]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Unknown symbol",
+			body: `{"variant": "ultimate", "board": ` +
+				`[[65,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],` +
+				`[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],` +
+				`[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0]]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Crosses moved twice",
+			body: `{"variant": "ultimate", "lastMove": {"x": 4, "y": 4}, "board": ` +
+				`[[88,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],` +
+				`[0,0,0,0,0,0,0,0,0],[0,0,0,0,88,0,0,0,0],[0,0,0,0,0,0,0,0,0],` +
+				`[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0],[0,0,0,0,0,0,0,0,0]]}`,
+			expStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "Not 9x9",
+			body:          `{"variant": "ultimate", "board": [[0,0,0],[0,0,0],[0,0,0]]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			TicTacToeStateHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+			if tt.expStatusCode != http.StatusOK {
+				return
+			}
+
+			resp := UltimateStateResponse{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Len(t, resp.Board, ultimateSize)
+			assert.Len(t, resp.MetaBoard, ultimateSubSize)
+			assert.Equal(t, tt.expTurn, resp.Turn)
+			if assert.NotNil(t, resp.LastMove) {
+				assert.Equal(t, opponent(SquareState(resp.NextPlayer)),
+					resp.Board[resp.LastMove.Y][resp.LastMove.X])
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Errors returned for requests describing a game that cannot be played.
+var (
+	// ErrInvalidBoardSize is returned for boards that are too small or too
+	// large, or whose rows are not all the same length.
+	ErrInvalidBoardSize = errors.New("invalid board size")
+	// ErrInvalidBoardCount is returned for Notakto games without boards or
+	// with too many.
+	ErrInvalidBoardCount = errors.New("invalid board count")
+	// ErrInvalidWinLength is returned for lines too short to play for or
+	// too long to fit on the board.
+	ErrInvalidWinLength = errors.New("invalid win length")
+	// ErrUnknownVariant is returned for variants the server does not play.
+	ErrUnknownVariant = errors.New("unknown variant")
+	// ErrInvalidPlayer is returned for players that are not in the game.
+	ErrInvalidPlayer = errors.New("invalid player")
+	// ErrInvalidPlayerCount is returned for multiplayer games with too few
+	// or too many players.
+	ErrInvalidPlayerCount = errors.New("invalid player count")
+	// ErrInvalidRole is returned for roles other than those of Order and
+	// Chaos, and for roles in variants that are not played by role.
+	ErrInvalidRole = errors.New("invalid role")
+	// ErrPlayersByRole is returned for symbols given as players in variants
+	// whose players are chosen by role.
+	ErrPlayersByRole = errors.New("players are chosen by role")
+	// ErrInvalidSymbol is returned for squares holding anything but a
+	// player's piece, a blocked square or nothing, and for moves placing a
+	// symbol the player may not.
+	ErrInvalidSymbol = errors.New("invalid symbol")
+	// ErrInvalidNumber is returned for numbers off the numerical board's
+	// range and for moves placing a number of the other player's parity.
+	ErrInvalidNumber = errors.New("invalid number")
+	// ErrOutOfBounds is returned for squares off the board.
+	ErrOutOfBounds = errors.New("invalid coordinate")
+	// ErrInvalidSetup is returned for blocked squares and handicap pieces
+	// that clash with each other or with the board.
+	ErrInvalidSetup = errors.New("invalid setup")
+	// ErrFloatingPiece is returned for gravity boards with a piece above an
+	// empty square.
+	ErrFloatingPiece = errors.New("floating piece")
+	// ErrUnknownEngine is returned, wrapped with the engine's name, for
+	// engines that are not registered.
+	ErrUnknownEngine = errors.New("unknown engine")
+	// ErrUnknownDifficulty is returned for difficulties other than those
+	// the engines play at.
+	ErrUnknownDifficulty = errors.New("unknown difficulty")
+	// ErrInvalidMistakeChance is returned for mistake chances outside 0 to
+	// 1.
+	ErrInvalidMistakeChance = errors.New("invalid mistake chance")
+	// ErrUnsupportedOption is returned, wrapped with the option's name, for
+	// options set in requests for variants that do not honour them.
+	ErrUnsupportedOption = errors.New("option not supported by the variant")
+	// ErrInvalidSearchLimit is returned for negative iteration counts and
+	// time budgets.
+	ErrInvalidSearchLimit = errors.New("invalid search limit")
+	// ErrTurnImbalance is returned for boards on which the players could
+	// not have taken turns, where the first player has neither as many
+	// pieces as the second nor one more.
+	ErrTurnImbalance = errors.New("invalid piece count")
+	// ErrUnreachablePosition is returned for boards that could not come
+	// about in play, such as those on which both players have a line.
+	ErrUnreachablePosition = errors.New("unreachable position")
+)
+
+// Errors returned for moves the rules do not allow, whether asked of the
+// computer or replayed from a request.
+var (
+	// ErrOccupied is returned for moves onto a square that is not empty.
+	ErrOccupied = errors.New("already occupied")
+	// ErrIllegalMove is returned for moves onto a square the rules rule
+	// out, such as one a gravity piece would fall through.
+	ErrIllegalMove = errors.New("illegal move")
+	// ErrInvalidColumn is returned for drops into columns off the board.
+	ErrInvalidColumn = errors.New("invalid column")
+	// ErrColumnFull is returned for drops into columns without room.
+	ErrColumnFull = errors.New("column full")
+	// ErrColumnOutsideGravity is returned for column moves in variants
+	// other than gravity.
+	ErrColumnOutsideGravity = errors.New("column moves are only played in the gravity variant")
+	// ErrNumberUsed is returned for numbers placed a second time.
+	ErrNumberUsed = errors.New("number already used")
+	// ErrDeadBoard is returned for Notakto moves on a board that is out of
+	// play.
+	ErrDeadBoard = errors.New("dead board")
+	// ErrInvalidLastMove is returned for ultimate games whose last move is
+	// not on the board or does not show the last mover's piece.
+	ErrInvalidLastMove = errors.New("invalid last move")
+	// ErrAllPiecesPlaced is returned for placements by a Three Men's Morris
+	// player who has placed all their pieces.
+	ErrAllPiecesPlaced = errors.New("all pieces placed")
+	// ErrPiecesToPlace is returned for slides by a Three Men's Morris
+	// player who has pieces still to place.
+	ErrPiecesToPlace = errors.New("pieces still to place")
+	// ErrNoPieceToMove is returned for slides from a square that does not
+	// hold a piece of the player whose turn it is.
+	ErrNoPieceToMove = errors.New("no piece to move")
+	// ErrNotAdjacent is returned for slides between squares that no line
+	// joins.
+	ErrNotAdjacent = errors.New("squares not adjacent")
+	// ErrGameOver is returned for moves made after the game ended.
+	ErrGameOver = errors.New("move after the end of the game")
+	// ErrNotComputersTurn is returned for requests for the computer's move
+	// when it is the human's turn.
+	ErrNotComputersTurn = errors.New("not the computer's turn")
+	// ErrNotHumansTurn is returned for human moves played out of turn.
+	ErrNotHumansTurn = errors.New("not the human's turn")
+)
+
+// variantOptions lists the options honoured by the variants with handlers of
+// their own, which do not choose their engine from the registry. The other
+// variants honour every option.
+var variantOptions = map[Variant][]string{
+	VariantQubic:       {"timeBudget"},
+	VariantUltimate:    {"iterations", "timeBudget", "seed"},
+	VariantNotakto:     {},
+	VariantNumerical:   {},
+	VariantMultiplayer: {"timeBudget"},
+	VariantInfinite:    {},
+	VariantMorris:      {},
+}
+
+// requestOptions holds the options of a game state request that choose how
+// the computer plays.
+type requestOptions struct {
+	Engine        string     `json:"engine"`
+	Difficulty    Difficulty `json:"difficulty"`
+	MistakeChance *float64   `json:"mistakeChance"`
+	Seed          int64      `json:"seed"`
+	Iterations    int        `json:"iterations"`
+	TimeBudget    int        `json:"timeBudget"`
+}
+
+// check returns ErrUnsupportedOption for the first option set that variant
+// does not honour, so that it is never silently ignored.
+func (o requestOptions) check(variant Variant) error {
+	supported, ok := variantOptions[variant]
+	if !ok {
+		return nil
+	}
+	set := []struct {
+		name string
+		set  bool
+	}{
+		{"engine", o.Engine != ""},
+		{"difficulty", o.Difficulty != ""},
+		{"mistakeChance", o.MistakeChance != nil},
+		{"seed", o.Seed != 0},
+		{"iterations", o.Iterations != 0},
+		{"timeBudget", o.TimeBudget != 0},
+	}
+options:
+	for _, option := range set {
+		if !option.set {
+			continue
+		}
+		for _, name := range supported {
+			if name == option.name {
+				continue options
+			}
+		}
+		return fmt.Errorf("%w: %s", ErrUnsupportedOption, option.name)
+	}
+
+	return nil
+}
+
+// errorStatus returns the status code of the response to a request that failed
+// validation with err: 422 for a well-formed board showing a position that
+// play could never lead to, and 400 for anything else.
+func errorStatus(err error) int {
+	if errors.Is(err, ErrTurnImbalance) || errors.Is(err, ErrUnreachablePosition) {
+		return http.StatusUnprocessableEntity
+	}
+
+	return http.StatusBadRequest
+}
+
+// validateSymbols checks that every square of the board is empty, blocked or
+// holds a piece of either player.
+func (t *TicTacToeState) validateSymbols() error {
+	for _, row := range t.Board {
+		for _, square := range row {
+			if square != SquareStateEmpty && square != SquareStateBlocked && !isPlayer(square) {
+				return ErrInvalidSymbol
+			}
+		}
+	}
+
+	return nil
+}
+
+// validatePosition checks that play could have led to the position on the
+// board, on which the first player has played first pieces and the second
+// player second. The game ends as soon as a line is completed, so there can
+// never be lines of both symbols, and a player's line must have been completed
+// by the last move: the first player's when they have played one piece more
+// than the second, and the second player's when they have played as many.
+// Which player completed a line of shared symbols cannot be told, and a line
+// of handicap pieces ends the game before anyone has moved.
+func (t *TicTacToeState) validatePosition(first, second int) error {
+	lines := t.linePlayers()
+	if lines[SquareStateCross] && lines[SquareStateNaught] {
+		return ErrUnreachablePosition
+	}
+	if t.sharesSymbols() || first+second == 0 {
+		return nil
+	}
+	if lines[t.firstPlayer()] && first != second+1 || lines[t.secondPlayer()] && first != second {
+		return ErrUnreachablePosition
+	}
+
+	return nil
+}
+
+// linePlayers returns the set of symbols that make up a line on the board.
+func (t *TicTacToeState) linePlayers() map[SquareState]bool {
+	k := t.winLength()
+	lines := map[SquareState]bool{}
+	for _, d := range lineDirections {
+		for y := range t.Board {
+			for x := range t.Board[y] {
+				if t.isLine(x, y, d[0], d[1], k) {
+					lines[t.Board[y][x]] = true
+				}
+			}
+		}
+	}
+
+	return lines
+}
@@ -0,0 +1,278 @@
+package game
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidation_Initialize(t *testing.T) {
+	x, o := SquareStateCross, SquareStateNaught
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		expErr    error
+	}{
+		{
+			name:      "Jagged rows",
+			gameState: TicTacToeState{Board: [][]SquareState{{0, 0, 0}, {0, 0}, {0, 0, 0}}},
+			expErr:    ErrInvalidBoardSize,
+		},
+		{
+			name:      "Jagged gravity rows",
+			gameState: TicTacToeState{Board: [][]SquareState{{0, 0, 0}, {0, 0, 0, 0}}, Variant: VariantGravity, WinLength: 2},
+			expErr:    ErrInvalidBoardSize,
+		},
+		{
+			name:      "Unknown rune",
+			gameState: TicTacToeState{Board: [][]SquareState{{x, 'A', 0}, {0, 0, 0}, {0, 0, 0}}},
+			expErr:    ErrInvalidSymbol,
+		},
+		{
+			name:      "Third player's piece",
+			gameState: TicTacToeState{Board: [][]SquareState{{x, SquareStateDelta, 0}, {0, 0, 0}, {0, 0, 0}}},
+			expErr:    ErrInvalidSymbol,
+		},
+		{
+			name:      "More naughts than crosses",
+			gameState: TicTacToeState{Board: [][]SquareState{{o, o, 0}, {x, 0, 0}, {0, 0, 0}}},
+			expErr:    ErrTurnImbalance,
+		},
+		{
+			name:      "Crosses two ahead",
+			gameState: TicTacToeState{Board: [][]SquareState{{x, x, x}, {o, 0, 0}, {0, 0, 0}}},
+			expErr:    ErrTurnImbalance,
+		},
+		{
+			name:      "Lines for both players",
+			gameState: TicTacToeState{Board: [][]SquareState{{x, x, x}, {o, o, o}, {0, 0, 0}}},
+			expErr:    ErrUnreachablePosition,
+		},
+		{
+			name: "Lines of both symbols in the wild variant",
+			gameState: TicTacToeState{
+				Board:   [][]SquareState{{x, x, x}, {o, o, o}, {0, 0, 0}},
+				Variant: VariantWild,
+			},
+			expErr: ErrUnreachablePosition,
+		},
+		{
+			name:      "Crosses' line on crosses' turn",
+			gameState: TicTacToeState{Board: [][]SquareState{{x, x, x}, {o, o, 0}, {o, 0, 0}}},
+			expErr:    ErrUnreachablePosition,
+		},
+		{
+			name:      "Naughts' line on naughts' turn",
+			gameState: TicTacToeState{Board: [][]SquareState{{o, o, o}, {x, x, 0}, {x, 0, x}}},
+			expErr:    ErrUnreachablePosition,
+		},
+		{
+			name:      "Naughts' line on crosses' turn",
+			gameState: TicTacToeState{Board: [][]SquareState{{o, o, o}, {x, x, 0}, {x, 0, 0}}},
+		},
+		{
+			name: "Line of the second player to move",
+			gameState: TicTacToeState{
+				Board:       [][]SquareState{{x, x, x}, {o, o, 0}, {o, 0, 0}},
+				FirstPlayer: o,
+			},
+		},
+		{
+			name:      "Two lines for one player",
+			gameState: TicTacToeState{Board: [][]SquareState{{x, x, x}, {o, x, o}, {o, x, o}}},
+		},
+		{
+			name:      "Win length longer than the board",
+			gameState: TicTacToeState{Size: 3, WinLength: 4},
+			expErr:    ErrInvalidWinLength,
+		},
+		{
+			name:      "Floating piece",
+			gameState: TicTacToeState{Board: [][]SquareState{{x, 0}, {0, 0}}, Variant: VariantGravity, WinLength: 2},
+			expErr:    ErrFloatingPiece,
+		},
+		{
+			name:      "Unknown variant",
+			gameState: TicTacToeState{Variant: "hexagonal"},
+			expErr:    ErrUnknownVariant,
+		},
+		{
+			name:      "Setup off the board",
+			gameState: TicTacToeState{Handicap: []Move{{X: 0, Y: 3, Symbol: x}}},
+			expErr:    ErrOutOfBounds,
+		},
+		{
+			name:      "Square blocked twice",
+			gameState: TicTacToeState{Blocked: []Square{{X: 1, Y: 1}, {X: 1, Y: 1}}},
+			expErr:    ErrInvalidSetup,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.gameState.initialize()
+			assert.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
+
+func TestValidation_PlaceSymbol(t *testing.T) {
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		x, y      int
+		symbol    SquareState
+		expErr    error
+	}{
+		{
+			name:      "Just past the last column",
+			gameState: TicTacToeState{Board: makeBoard(3), Turn: 1},
+			x:         3,
+			expErr:    ErrOutOfBounds,
+		},
+		{
+			name:      "Just past the last row",
+			gameState: TicTacToeState{Board: makeBoard(3), Turn: 1},
+			y:         3,
+			expErr:    ErrOutOfBounds,
+		},
+		{
+			name:      "Past the last column of a wide board",
+			gameState: TicTacToeState{Board: makeRectangle(4, 2), Turn: 1},
+			x:         4,
+			y:         1,
+			expErr:    ErrOutOfBounds,
+		},
+		{
+			name:      "Negative",
+			gameState: TicTacToeState{Board: makeBoard(3), Turn: 1},
+			x:         -1,
+			expErr:    ErrOutOfBounds,
+		},
+		{
+			name:      "Opponent's symbol",
+			gameState: TicTacToeState{Board: makeBoard(3), Turn: 1},
+			symbol:    SquareStateNaught,
+			expErr:    ErrInvalidSymbol,
+		},
+		{
+			name:      "Last square of a wide board",
+			gameState: TicTacToeState{Board: makeRectangle(4, 2), Turn: 1},
+			x:         3,
+			y:         1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.gameState.placeSymbol(tt.x, tt.y, tt.symbol)
+			assert.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
+
+func TestValidation_Handlers(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+	}{
+		{
+			name:          "Jagged rows",
+			body:          `{"board": [[0,0,0],[0,0],[0,0,0]]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Unknown rune",
+			body:          `{"board": [[88,65,0],[0,0,0],[0,0,0]]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Setup off the board",
+			body:          `{"blocked": [{"x":5,"y":5}]}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "More naughts than crosses",
+			body:          `{"board": [[48,48,0],[88,0,0],[0,0,0]]}`,
+			expStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "Lines for both players",
+			body:          `{"board": [[88,88,88],[48,48,48],[0,0,0]]}`,
+			expStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "Line completed out of turn",
+			body:          `{"board": [[88,88,88],[48,48,0],[48,0,0]]}`,
+			expStatusCode: http.StatusUnprocessableEntity,
+		},
+	}
+	handlers := map[string]func(http.ResponseWriter, *http.Request){
+		"state":    func(w http.ResponseWriter, r *http.Request) { TicTacToeStateHandler(w, r, nil) },
+		"analysis": func(w http.ResponseWriter, r *http.Request) { AnalysisHandler(w, r, nil) },
+	}
+	for _, tt := range tests {
+		for name, handler := range handlers {
+			t.Run(tt.name+"/"+name, func(t *testing.T) {
+				r := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(tt.body))
+				w := httptest.NewRecorder()
+				handler(w, r)
+				assert.Equal(t, tt.expStatusCode, w.Code)
+			})
+		}
+	}
+}
+
+func TestValidation_VariantOptions(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+	}{
+		{
+			name:          "Difficulty in Qubic",
+			body:          `{"variant": "qubic", "difficulty": "random"}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Engine in Three Men's Morris",
+			body:          `{"variant": "morris", "engine": "mcts"}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Seed in Notakto",
+			body:          `{"variant": "notakto", "seed": 1}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Mistake chance in multiplayer",
+			body:          `{"variant": "multiplayer", "mistakeChance": 0}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Time budget in numerical",
+			body:          `{"variant": "numerical", "timeBudget": 50}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Iterations in infinite",
+			body:          `{"variant": "infinite", "iterations": 10}`,
+			expStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "Time budget in Qubic",
+			body:          `{"variant": "qubic", "timeBudget": 50}`,
+			expStatusCode: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			TicTacToeStateHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+		})
+	}
+}
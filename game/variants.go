@@ -0,0 +1,47 @@
+package game
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// Variants lists the variants a game may be played by.
+var Variants = []Variant{
+	VariantStandard,
+	VariantMisere,
+	VariantQubic,
+	VariantUltimate,
+	VariantGomoku,
+	VariantGravity,
+	VariantWild,
+	VariantNotakto,
+	VariantOrderChaos,
+	VariantToroidal,
+	VariantNumerical,
+	VariantMultiplayer,
+	VariantInfinite,
+	VariantMorris,
+}
+
+type VariantsResponse struct {
+	Variants []Variant `json:"variants"`
+	Engines  []string  `json:"engines"`
+}
+
+// VariantsHandler responds with a VariantsResponse listing the variants and
+// the engines a game state request may ask for.
+func VariantsHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	b, err := json.Marshal(VariantsResponse{Variants: Variants, Engines: Engines()})
+	if err != nil {
+		writeHTTPError(w, http.StatusInternalServerError, "failed to marshal response", err)
+		return
+	}
+
+	_, err = w.Write(b)
+	if err != nil {
+		log.Print(err)
+	}
+}
@@ -0,0 +1,35 @@
+package game
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariantsHandler(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/variants", nil)
+	w := httptest.NewRecorder()
+	VariantsHandler(w, r, nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	resp := VariantsResponse{}
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Variants, VariantToroidal)
+	assert.Equal(t, Variants, resp.Variants)
+	assert.Equal(t, Engines(), resp.Engines)
+}
+
+func TestVariants_Playable(t *testing.T) {
+	for _, variant := range Variants {
+		if _, ok := variantOptions[variant]; ok {
+			// The variant is described by a state of its own.
+			continue
+		}
+		gs := TicTacToeState{Variant: variant}
+		assert.NoError(t, gs.initialize(), variant)
+	}
+}
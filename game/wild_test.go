@@ -0,0 +1,164 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// wildWinBoard is a wild position in which crosses are to move and win by
+// placing a naught at 2, 0.
+func wildWinBoard() TicTacToeState {
+	return TicTacToeState{
+		Board: [][]SquareState{
+			{SquareStateNaught, SquareStateNaught, SquareStateEmpty},
+			{SquareStateCross, SquareStateEmpty, SquareStateEmpty},
+			{SquareStateEmpty, SquareStateEmpty, SquareStateCross},
+		},
+		Variant: VariantWild,
+		Turn:    5,
+	}
+}
+
+func TestWild_PlaceSymbol(t *testing.T) {
+	tests := []struct {
+		name      string
+		gameState TicTacToeState
+		symbol    SquareState
+		expErr    error
+		expSquare SquareState
+	}{
+		{
+			name:      "Own symbol",
+			gameState: TicTacToeState{Board: makeBoard(3), Variant: VariantWild, Turn: 1},
+			expSquare: SquareStateCross,
+		},
+		{
+			name:      "Opponent's symbol",
+			gameState: TicTacToeState{Board: makeBoard(3), Variant: VariantWild, Turn: 1},
+			symbol:    SquareStateNaught,
+			expSquare: SquareStateNaught,
+		},
+		{
+			name:      "Opponent's symbol outside the wild variant",
+			gameState: TicTacToeState{Board: makeBoard(3), Turn: 1},
+			symbol:    SquareStateNaught,
+			expErr:    ErrInvalidSymbol,
+		},
+		{
+			name:      "Not a symbol",
+			gameState: TicTacToeState{Board: makeBoard(3), Variant: VariantWild, Turn: 1},
+			symbol:    'Z',
+			expErr:    ErrInvalidSymbol,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.gameState.placeSymbol(1, 1, tt.symbol)
+			assert.ErrorIs(t, err, tt.expErr)
+			assert.Equal(t, tt.expSquare, tt.gameState.Board[1][1])
+		})
+	}
+}
+
+func TestWild_Winner(t *testing.T) {
+	gs := wildWinBoard()
+	assert.NoError(t, gs.placeSymbol(2, 0, SquareStateNaught))
+
+	result, winningRow := gs.getGameResult()
+	assert.Equal(t, ResultNInARow, result)
+	assert.Equal(t, rune(SquareStateNaught), linePlayer(winningRow))
+	assert.Equal(t, rune(SquareStateCross), gs.winner(result, winningRow))
+	over, winner := gs.outcome()
+	assert.True(t, over)
+	assert.Equal(t, rune(SquareStateCross), winner)
+}
+
+func TestWild_ComputeMove(t *testing.T) {
+	expMove := Move{X: 2, Y: 0, Symbol: SquareStateNaught}
+	for _, name := range Engines() {
+		if name == EngineRandom {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			engine, err := NewEngine(name, EngineOptions{Iterations: 2000, Rand: rand.New(rand.NewSource(1))})
+			assert.NoError(t, err)
+			move, _, err := engine.ComputeMove(context.Background(), wildWinBoard())
+			assert.NoError(t, err)
+			assert.Equal(t, expMove, move)
+		})
+	}
+}
+
+func TestWild_FirstPlayerWins(t *testing.T) {
+	gameState := TicTacToeState{Board: makeBoard(3), Variant: VariantWild, Turn: 1}
+	score, _, solved, err := deepen(context.Background(), gameState, 0)
+	assert.NoError(t, err)
+	assert.True(t, solved)
+	assert.True(t, score > scoreWin/2)
+}
+
+func TestWild_BeginnerLeavesNoWin(t *testing.T) {
+	gameState := TicTacToeState{Board: makeBoard(3), Variant: VariantWild, Turn: 1}
+	assert.NoError(t, gameState.placeSymbol(0, 0, SquareStateCross))
+	for seed := int64(1); seed <= 20; seed++ {
+		e := &beginnerEngine{rng: rand.New(rand.NewSource(seed))}
+		move, _, err := e.ComputeMove(context.Background(), gameState)
+		assert.NoError(t, err)
+
+		gs := gameState.clone()
+		assert.NoError(t, gs.placeSymbol(move.X, move.Y, move.Symbol))
+		assert.False(t, gs.hasWinningMove(), "move %v", move)
+	}
+}
+
+func TestWildStateHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expStatusCode int
+		expResult     Result
+		expWinner     rune
+	}{
+		{
+			name:          "Computer completes the naughts' line",
+			body:          `{"variant": "wild", "board": [[48,48,0],[88,0,0],[0,0,88]]}`,
+			expStatusCode: http.StatusOK,
+			expResult:     ResultNInARow,
+			expWinner:     rune(SquareStateCross),
+		},
+		{
+			name:          "Any mix of symbols",
+			body:          `{"variant": "wild", "timeBudget": 50, "board": [[48,0,0],[0,0,48],[0,0,0]]}`,
+			expStatusCode: http.StatusOK,
+		},
+		{
+			name:          "Unbalanced outside the wild variant",
+			body:          `{"board": [[48,0,0],[48,0,0],[0,0,0]]}`,
+			expStatusCode: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/game-state", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			TicTacToeStateHandler(w, r, nil)
+			assert.Equal(t, tt.expStatusCode, w.Code)
+			if tt.expStatusCode != http.StatusOK {
+				return
+			}
+
+			resp := TicTacToeStateResponse{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expResult, resp.Result)
+			assert.Equal(t, tt.expWinner, resp.Winner)
+		})
+	}
+}
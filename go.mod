@@ -5,6 +5,5 @@ go 1.14
 require (
 	github.com/joho/godotenv v1.3.0
 	github.com/julienschmidt/httprouter v1.3.0
-	github.com/stretchr/testify v1.6.1
-	golang.org/x/tools v0.0.0-20201009032223-96877f285f7e // indirect
+	github.com/stretchr/testify v1.9.0
 )
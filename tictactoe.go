@@ -19,6 +19,8 @@ func main() {
 
 	router := httprouter.New()
 	router.PUT("/game-state", game.TicTacToeStateHandler)
+	router.POST("/analysis", game.AnalysisHandler)
+	router.GET("/variants", game.VariantsHandler)
 	router.NotFound = http.FileServer(http.Dir("static"))
 
 	port := os.Getenv("PORT")